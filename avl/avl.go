@@ -9,6 +9,7 @@ package avl
 
 import (
 	"fmt"
+	"sort"
 
 	"gopkg.in/typ.v4"
 )
@@ -82,6 +83,24 @@ func (n *Tree[T]) Add(value T) {
 	n.count++
 }
 
+// Upsert will replace the stored value of an element that is equal to the
+// given value, as decided by the tree's comparator, or add the value to the
+// tree if no such element exists. Returns true if the value was added, or
+// false if an existing element was replaced.
+//
+// This is useful when the comparator only looks at a key, and the other
+// fields of the value should be updated without adding a duplicate entry.
+func (n *Tree[T]) Upsert(value T) bool {
+	if n.root != nil {
+		if existing := n.root.find(value, n.compare); existing != nil {
+			existing.value = value
+			return false
+		}
+	}
+	n.Add(value)
+	return true
+}
+
 // Remove will try to remove the first occurrence of a value from the tree.
 func (n *Tree[T]) Remove(value T) bool {
 	if n.root == nil {
@@ -89,7 +108,9 @@ func (n *Tree[T]) Remove(value T) bool {
 	}
 	newRoot, ok := n.root.remove(value, n.compare)
 	n.root = newRoot
-	n.count--
+	if ok {
+		n.count--
+	}
 	return ok
 }
 
@@ -135,6 +156,36 @@ func (n *Tree[T]) WalkPostOrder(walker func(value T)) {
 	n.root.walkPostOrder(walker)
 }
 
+// All returns an iterator that yields all values in this tree in sorted
+// order, the same order as WalkInOrder. Unlike WalkInOrder, the iteration can
+// be stopped early, and the tree is traversed iteratively using an explicit
+// stack instead of recursion.
+//
+// The returned function is compatible with Go 1.23's iter.Seq and
+// range-over-func loops:
+//
+//	for v := range tree.All() {
+//		// ...
+//	}
+func (n *Tree[T]) All() func(yield func(value T) bool) {
+	return func(yield func(value T) bool) {
+		var stack []*node[T]
+		current := n.root
+		for current != nil || len(stack) > 0 {
+			for current != nil {
+				stack = append(stack, current)
+				current = current.left
+			}
+			current = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(current.value) {
+				return
+			}
+			current = current.right
+		}
+	}
+}
+
 // SlicePreOrder returns a slice of values by walking the tree in pre-order.
 // See WalkPreOrder for more details.
 func (n *Tree[T]) SlicePreOrder() []T {
@@ -154,6 +205,43 @@ func (n *Tree[T]) SlicePostOrder() []T {
 	return n.slice(n.WalkPostOrder)
 }
 
+// MarshalSlice returns a slice of all values in this tree in sorted order.
+// This is the same as SliceInOrder, but is meant to be used together with
+// UnmarshalSlice to store and later restore the tree.
+//
+// Round-tripping does not preserve the original shape of the tree. Instead,
+// UnmarshalSlice rebuilds a fully balanced tree from the sorted values, which
+// is O(n) and at least as balanced as the original. The sorted order is used
+// over pre-order, as it does not depend on the tree's internal layout and can
+// also be produced from any other sorted source.
+func (n *Tree[T]) MarshalSlice() []T {
+	return n.SliceInOrder()
+}
+
+// UnmarshalSlice replaces all values in this tree with the values from the
+// given slice, such as one produced by MarshalSlice. The resulting tree
+// contains the same values, but not necessarily in the same shape as the tree
+// the slice was produced from.
+//
+// Instead of adding the values one by one, the tree is built in a balanced
+// layout directly from the sorted slice. This makes it an O(n) operation, as
+// opposed to O(n log n) when using Add. If the slice is not sorted, then a
+// sorted copy of it is made first.
+func (n *Tree[T]) UnmarshalSlice(values []T) {
+	if n.compare == nil {
+		panic("avl: tree is not initialized")
+	}
+	less := func(i, j int) bool {
+		return n.compare(values[i], values[j]) < 0
+	}
+	if !sort.SliceIsSorted(values, less) {
+		values = append([]T(nil), values...)
+		sort.SliceStable(values, less)
+	}
+	n.root = newBalancedNode(values)
+	n.count = len(values)
+}
+
 func (n *Tree[T]) slice(f func(f func(value T))) []T {
 	slice := make([]T, 0, n.count)
 	f(func(v T) {
@@ -177,6 +265,20 @@ type node[T any] struct {
 	height int
 }
 
+func newBalancedNode[T any](sorted []T) *node[T] {
+	if len(sorted) == 0 {
+		return nil
+	}
+	mid := len(sorted) / 2
+	n := &node[T]{
+		value: sorted[mid],
+		left:  newBalancedNode(sorted[:mid]),
+		right: newBalancedNode(sorted[mid+1:]),
+	}
+	n.height = n.calcHeight()
+	return n
+}
+
 func (n *node[T]) String() string {
 	return fmt.Sprint(n.value)
 }
@@ -217,19 +319,18 @@ func (n *node[T]) contains(value T, compare func(a, b T) int) bool {
 
 func (n *node[T]) find(value T, compare func(a, b T) int) *node[T] {
 	current := n
-	for {
+	for current != nil {
 		cmp := compare(value, current.value)
 		switch {
-		case cmp == 0:
-			return current
-		case current.left != nil && cmp < 0:
+		case cmp < 0:
 			current = current.left
-		case current.right != nil:
+		case cmp > 0:
 			current = current.right
 		default:
-			return nil
+			return current
 		}
 	}
+	return nil
 }
 
 func (n *node[T]) remove(value T, compare func(a, b T) int) (*node[T], bool) {
@@ -254,7 +355,10 @@ func (n *node[T]) remove(value T, compare func(a, b T) int) (*node[T], bool) {
 			return leftMost.rebalance(), true
 		}
 	}
-	if n.left != nil && cmp < 0 {
+	if cmp < 0 {
+		if n.left == nil {
+			return n, false
+		}
 		if newNode, ok := n.left.remove(value, compare); ok {
 			n.left = newNode
 			n.height = n.calcHeight()
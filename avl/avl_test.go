@@ -218,6 +218,191 @@ func FuzzOrderedTree_AddRemove(f *testing.F) {
 	})
 }
 
+func TestTreeMarshalSlice(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, v := range []int{8, 3, 10, 1, 6, 14, 4, 7, 13, 2, 5, 9, 11, 12} {
+		tree.Add(v)
+	}
+
+	restored := NewOrdered[int]()
+	restored.UnmarshalSlice(tree.MarshalSlice())
+
+	want := tree.SliceInOrder()
+	got := restored.SliceInOrder()
+	if len(want) != len(got) {
+		t.Fatalf("want len=%d, got len=%d", len(want), len(got))
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			t.Errorf("index %d: want %d, got %d", i, want[i], got[i])
+		}
+	}
+	if restored.Len() != tree.Len() {
+		t.Errorf("want Len()=%d, got %d", tree.Len(), restored.Len())
+	}
+	assertAVLBalanced(t, restored.root)
+}
+
+func TestTreeUnmarshalSliceUnsorted(t *testing.T) {
+	tree := NewOrdered[int]()
+	values := []int{5, 2, 4, 1, 3}
+	tree.UnmarshalSlice(values)
+
+	got := tree.SliceInOrder()
+	want := []int{1, 2, 3, 4, 5}
+	for i := range want {
+		if want[i] != got[i] {
+			t.Errorf("index %d: want %d, got %d", i, want[i], got[i])
+		}
+	}
+	if values[0] != 5 {
+		t.Errorf("input slice was modified: %v", values)
+	}
+	assertAVLBalanced(t, tree.root)
+}
+
+func assertAVLBalanced[T any](t *testing.T, n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	left := assertAVLBalanced(t, n.left)
+	right := assertAVLBalanced(t, n.right)
+	if left-right > 1 || right-left > 1 {
+		t.Errorf("node %v is unbalanced: left height %d, right height %d", n.value, left, right)
+	}
+	if left > right {
+		return left + 1
+	}
+	return right + 1
+}
+
+func TestTreeAll(t *testing.T) {
+	tree := NewOrdered[int]()
+	for i := 0; i < 10000; i++ {
+		tree.Add((i * 7919) % 10007)
+	}
+	want := tree.SliceInOrder()
+	var got []int
+	tree.All()(func(value int) bool {
+		got = append(got, value)
+		return true
+	})
+	if len(want) != len(got) {
+		t.Fatalf("want len=%d, got len=%d", len(want), len(got))
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			t.Fatalf("index %d: want %d, got %d", i, want[i], got[i])
+		}
+	}
+}
+
+func TestTreeAllDegenerate(t *testing.T) {
+	// Deliberately unbalanced tree, to make sure the iteration does not
+	// depend on the tree's height.
+	const depth = 100000
+	var root *intNode
+	for i := 0; i < depth; i++ {
+		root = &intNode{value: depth - i - 1, right: root}
+	}
+	tree := Tree[int]{root: root, count: depth}
+	var count int
+	prev := -1
+	tree.All()(func(value int) bool {
+		if value <= prev {
+			t.Fatalf("value %d came after %d", value, prev)
+		}
+		prev = value
+		count++
+		return true
+	})
+	if count != depth {
+		t.Errorf("want %d values, got %d", depth, count)
+	}
+}
+
+func TestTreeAllBreak(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, v := range []int{5, 3, 1, 4, 2} {
+		tree.Add(v)
+	}
+	var got []int
+	tree.All()(func(value int) bool {
+		got = append(got, value)
+		return len(got) < 3
+	})
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("want [1 2 3], got %v", got)
+	}
+}
+
+func TestTreeContainsCustomComparator(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	tree := NewFunc(func(u user) int {
+		return u.id
+	})
+	for i, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		tree.Add(user{i * 10, name})
+	}
+
+	if !tree.Contains(user{30, "other name"}) {
+		t.Error("want Contains to match on compared key, got false")
+	}
+	for _, id := range []int{-5, 5, 35, 65} {
+		if tree.Contains(user{id, "d"}) {
+			t.Errorf("want Contains(id=%d)==false, got true", id)
+		}
+	}
+}
+
+func TestTreeRemoveMissing(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, v := range []int{5, 3, 8} {
+		tree.Add(v)
+	}
+	if tree.Remove(1) {
+		t.Error("want Remove(1)==false, got true")
+	}
+	if tree.Len() != 3 {
+		t.Errorf("want Len()==3 after removing missing value, got %d", tree.Len())
+	}
+}
+
+func TestTreeUpsert(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	tree := NewFunc(func(u user) int {
+		return u.id
+	})
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		if !tree.Upsert(user{i * 10, name}) {
+			t.Errorf("want Upsert(id=%d)==true for new value, got false", i*10)
+		}
+	}
+
+	if tree.Upsert(user{20, "updated"}) {
+		t.Error("want Upsert(id=20)==false for existing value, got true")
+	}
+	if tree.Len() != 5 {
+		t.Errorf("want Len()==5 after upserting existing value, got %d", tree.Len())
+	}
+	want := []user{{0, "a"}, {10, "b"}, {20, "updated"}, {30, "d"}, {40, "e"}}
+	got := tree.SliceInOrder()
+	if len(got) != len(want) {
+		t.Fatalf("want %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("want [%d]==%v, got %v", i, want[i], got[i])
+		}
+	}
+}
+
 func assertAVLNode[T comparable](t *testing.T, want, got *node[T]) {
 	assertAVLNodeRec(t, want, got, "root")
 }
@@ -8,6 +8,7 @@ package chans
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"gopkg.in/typ.v4"
@@ -101,3 +102,106 @@ func RecvQueuedFull[C Receiver[V], B ~[]V, V any](ch C, buf B) int {
 	}
 	return index
 }
+
+// RecvN blocks while receiving values from a channel until either n values
+// has been received, the channel is closed, or the timeout has elapsed,
+// whichever comes first. The second return value is true only if all n values
+// were received. If the timeout duration is zero or negative, then no limit
+// is used.
+//
+// This differs from RecvQueued, which does not block when the channel's queue
+// buffer is empty.
+//
+// This function panics if n is negative. If n is zero, then an empty slice
+// and true is returned without receiving any values.
+func RecvN[C Receiver[V], V any](ch C, n int, timeout time.Duration) ([]V, bool) {
+	if n < 0 {
+		panic("chans.RecvN: n must not be negative")
+	}
+	buffer := make([]V, 0, n)
+	var timeoutCh <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+	for len(buffer) < n {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return buffer, false
+			}
+			buffer = append(buffer, v)
+		case <-timeoutCh:
+			return buffer, false
+		}
+	}
+	return buffer, true
+}
+
+// CollectN receives values from a channel until either there's no more
+// values in the channel's queue buffer, it has received max values, or the
+// channel is closed, whichever comes first, and then returns the received
+// values. Any values beyond max are left in the channel.
+//
+// This is useful as a bounded alternative to receiving all values until the
+// channel is closed, to prevent unbounded memory usage. Unlike RecvN, this
+// never blocks on an empty channel, and unlike RecvQueued, this stops on a
+// closed channel instead of receiving its zero values.
+func CollectN[C Receiver[V], V any](ch C, max int) []V {
+	var buffer []V
+	for len(buffer) < max {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return buffer
+			}
+			buffer = append(buffer, v)
+		default:
+			return buffer
+		}
+	}
+	return buffer
+}
+
+// FirstOf receives the first value sent on any of the given channels, or
+// cancels after a given timeout. Closed and nil channels are ignored, and
+// false is returned if all channels are closed before any value is received.
+// If the timeout duration is zero or negative, then no limit is used.
+//
+// As the number of channels is dynamic, this relies on reflect.Select, making
+// it slower than a regular select statement.
+func FirstOf[C Receiver[V], V any](timeout time.Duration, chans ...C) (V, bool) {
+	cases := make([]reflect.SelectCase, 0, len(chans)+1)
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		cases = append(cases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(timer.C),
+		})
+	}
+	numTimerCases := len(cases)
+	for _, ch := range chans {
+		if ch == nil {
+			continue
+		}
+		cases = append(cases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(ch),
+		})
+	}
+	for len(cases) > numTimerCases {
+		chosen, value, ok := reflect.Select(cases)
+		if chosen < numTimerCases {
+			break
+		}
+		if !ok {
+			cases = append(cases[:chosen], cases[chosen+1:]...)
+			continue
+		}
+		v, _ := value.Interface().(V) // nil interface values fail the type assertion
+		return v, true
+	}
+	return typ.Zero[V](), false
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecvQueuedReturnsWhenDrained(t *testing.T) {
+	ch := make(chan int, 5)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	got := RecvQueued(ch, 10)
+	assertValues(t, []int{1, 2, 3}, got)
+}
+
+func TestRecvQueuedFullReturnsWhenDrained(t *testing.T) {
+	ch := make(chan int, 5)
+	ch <- 1
+	ch <- 2
+	buf := make([]int, 10)
+	n := RecvQueuedFull(ch, buf)
+	if n != 2 {
+		t.Fatalf("want n=2, got n=%d", n)
+	}
+	assertValues(t, []int{1, 2}, buf[:n])
+}
+
+func TestRecvN(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+	got, ok := RecvN(ch, 3, time.Second)
+	if !ok {
+		t.Error("want ok=true, got false")
+	}
+	assertValues(t, []int{1, 2, 3}, got)
+}
+
+func TestRecvNClosed(t *testing.T) {
+	ch := make(chan int, 5)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	got, ok := RecvN(ch, 3, time.Second)
+	if ok {
+		t.Error("want ok=false, got true")
+	}
+	assertValues(t, []int{1, 2}, got)
+}
+
+func TestRecvNTimeout(t *testing.T) {
+	ch := make(chan int, 5)
+	ch <- 1
+	got, ok := RecvN(ch, 3, 10*time.Millisecond)
+	if ok {
+		t.Error("want ok=false, got true")
+	}
+	assertValues(t, []int{1}, got)
+}
+
+func TestRecvNZero(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	got, ok := RecvN(ch, 0, 0)
+	if !ok {
+		t.Error("want ok=true, got false")
+	}
+	assertValues(t, []int{}, got)
+	if left := len(ch); left != 1 {
+		t.Errorf("want 1 value left in channel, got %d", left)
+	}
+}
+
+func TestRecvNNegative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("want panic on negative n, got none")
+		}
+	}()
+	RecvN(make(chan int), -1, 0)
+}
+
+func TestCollectN(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []int
+		max      int
+		want     []int
+		wantLeft int
+	}{
+		{name: "more than max", values: []int{1, 2, 3, 4, 5}, max: 3, want: []int{1, 2, 3}, wantLeft: 2},
+		{name: "exactly max", values: []int{1, 2, 3}, max: 3, want: []int{1, 2, 3}, wantLeft: 0},
+		{name: "fewer than max", values: []int{1, 2}, max: 3, want: []int{1, 2}, wantLeft: 0},
+		{name: "empty", values: nil, max: 3, want: nil, wantLeft: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Channel is left open, so CollectN must return once drained.
+			ch := make(chan int, len(tc.values)+1)
+			for _, v := range tc.values {
+				ch <- v
+			}
+			got := collectNTimeout(t, ch, tc.max)
+			assertValues(t, tc.want, got)
+			if left := len(ch); left != tc.wantLeft {
+				t.Errorf("want %d values left in channel, got %d", tc.wantLeft, left)
+			}
+		})
+	}
+}
+
+func TestCollectNClosed(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	got := collectNTimeout(t, ch, 3)
+	assertValues(t, []int{1, 2}, got)
+}
+
+func collectNTimeout(t *testing.T, ch chan int, max int) []int {
+	t.Helper()
+	result := make(chan []int, 1)
+	go func() {
+		result <- CollectN(ch, max)
+	}()
+	select {
+	case got := <-result:
+		return got
+	case <-time.After(time.Second):
+		t.Fatal("CollectN blocked")
+		return nil
+	}
+}
+
+func TestFirstOf(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+	c := make(chan int)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		b <- 2
+	}()
+	got, ok := FirstOf(time.Second, a, b, c)
+	if !ok {
+		t.Fatal("want ok=true, got false")
+	}
+	if got != 2 {
+		t.Errorf("want 2, got %d", got)
+	}
+}
+
+func TestFirstOfIgnoresClosed(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int, 1)
+	close(a)
+	b <- 2
+	got, ok := FirstOf(time.Second, a, nil, b)
+	if !ok || got != 2 {
+		t.Errorf("want 2, true, got %d, %t", got, ok)
+	}
+}
+
+func TestFirstOfAllClosed(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+	close(a)
+	close(b)
+	if _, ok := FirstOf(0, a, b); ok {
+		t.Error("want ok=false, got true")
+	}
+}
+
+func TestFirstOfTimeout(t *testing.T) {
+	a := make(chan int)
+	if _, ok := FirstOf(10*time.Millisecond, a); ok {
+		t.Error("want ok=false, got true")
+	}
+}
+
+func assertValues[T comparable](t *testing.T, want, got []T) {
+	t.Helper()
+	if len(want) != len(got) {
+		t.Errorf("want %v, got %v", want, got)
+		return
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			t.Errorf("want %v, got %v", want, got)
+			return
+		}
+	}
+}
@@ -0,0 +1,465 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"gopkg.in/typ.v4"
+)
+
+// Generate returns a channel that receives all values from the slice in
+// order, and is then closed. The values are sent from a separate goroutine.
+//
+// The goroutine only exits once all values have been received, so use
+// GenerateCtx if the channel may be abandoned before it has been drained.
+func Generate[S ~[]E, E any](slice S) <-chan E {
+	out := make(chan E)
+	go func() {
+		defer close(out)
+		for _, v := range slice {
+			out <- v
+		}
+	}()
+	return out
+}
+
+// GenerateCtx returns a channel that receives all values from the slice in
+// order, and is then closed. The values are sent from a separate goroutine,
+// which stops sending values and closes the channel when the context is
+// cancelled.
+func GenerateCtx[S ~[]E, E any](ctx context.Context, slice S) <-chan E {
+	out := make(chan E)
+	go func() {
+		defer close(out)
+		for _, v := range slice {
+			if !SendContext(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Buffer returns a channel that receives all values from the input channel,
+// where the values are buffered in an unbounded queue in between. This
+// decouples the producer from the consumer, as sending to the input channel
+// never has to wait for the consumer to receive from the output channel.
+//
+// The output channel is closed when the input channel is closed and all
+// buffered values have been received.
+//
+// As the buffer has no size limit, a producer that is consistently faster
+// than the consumer will lead to an ever growing memory usage.
+func Buffer[C Receiver[V], V any](in C) <-chan V {
+	out := make(chan V)
+	go func() {
+		defer close(out)
+		var queue []V
+		for in != nil || len(queue) > 0 {
+			var send chan V
+			var next V
+			if len(queue) > 0 {
+				send = out
+				next = queue[0]
+			}
+			select {
+			case v, ok := <-in:
+				if !ok {
+					in = nil
+					continue
+				}
+				queue = append(queue, v)
+			case send <- next:
+				queue[0] = typ.Zero[V]()
+				queue = queue[1:]
+			}
+		}
+	}()
+	return out
+}
+
+// WithHeartbeat returns a channel that receives all values from the input
+// channel, but also receives the beat value whenever the interval has elapsed
+// without any value being received. This is useful to keep consumers alive
+// during idle periods.
+//
+// The output channel is closed when the input channel is closed. Will panic
+// if the interval is zero or negative.
+func WithHeartbeat[C Receiver[V], V any](in C, interval time.Duration, beat V) <-chan V {
+	if interval <= 0 {
+		panic("chans.WithHeartbeat: interval must be positive")
+	}
+	out := make(chan V)
+	go func() {
+		defer close(out)
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				out <- v
+			case <-timer.C:
+				out <- beat
+			}
+			resetTimer(timer, interval)
+		}
+	}()
+	return out
+}
+
+// IdleTimeout returns a channel that receives all values from the input
+// channel, together with a second channel that is closed if no value has been
+// received from the input channel within the idle duration since the previous
+// value, or since the call to IdleTimeout for the first value. When the
+// timeout fires, the output channel is closed and no more values are read
+// from the input channel.
+//
+// If the input channel is closed before the timeout fires, then the output
+// channel is closed while the timed out channel is left open.
+func IdleTimeout[C Receiver[V], V any](in C, idle time.Duration) (<-chan V, <-chan struct{}) {
+	out := make(chan V)
+	timedOut := make(chan struct{})
+	go func() {
+		defer close(out)
+		timer := time.NewTimer(idle)
+		defer timer.Stop()
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				out <- v
+			case <-timer.C:
+				close(timedOut)
+				return
+			}
+			resetTimer(timer, idle)
+		}
+	}()
+	return out, timedOut
+}
+
+// Batch returns a channel that receives slices of values from the input
+// channel, grouped into batches. A batch is sent once it has reached maxSize
+// values, or once maxWait has elapsed since the first value of the batch was
+// received, whichever comes first. If maxWait is zero or negative, then
+// batches are only sent once they are full. Any remaining values are sent as
+// a final partial batch when the input channel is closed. Empty batches are
+// never sent. Will panic if maxSize is zero or negative.
+//
+// The output channel is closed when the input channel is closed.
+func Batch[C Receiver[V], V any](in C, maxSize int, maxWait time.Duration) <-chan []V {
+	if maxSize <= 0 {
+		panic("chans.Batch: max size must be positive")
+	}
+	out := make(chan []V)
+	go func() {
+		defer close(out)
+		var (
+			batch   []V
+			timer   *time.Timer
+			timeout <-chan time.Time
+		)
+		flush := func() {
+			if len(batch) > 0 {
+				out <- batch
+				batch = nil
+			}
+			timeout = nil
+		}
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && maxWait > 0 {
+					if timer == nil {
+						timer = time.NewTimer(maxWait)
+					} else {
+						resetTimer(timer, maxWait)
+					}
+					timeout = timer.C
+				}
+				if len(batch) >= maxSize {
+					flush()
+				}
+			case <-timeout:
+				flush()
+			}
+		}
+	}()
+	return out
+}
+
+func resetTimer(timer *time.Timer, d time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(d)
+}
+
+// Split returns two channels, where values from the input channel are sent to
+// the first channel if the predicate returns true, or to the second channel
+// otherwise. Both output channels are closed when the input channel is closed.
+//
+// Both output channels must be consumed, as sending to one of them blocks
+// any further values from being sent to either of them.
+func Split[C Receiver[V], V any](in C, pred func(value V) bool) (matching <-chan V, rest <-chan V) {
+	matchingCh := make(chan V)
+	restCh := make(chan V)
+	go func() {
+		defer close(matchingCh)
+		defer close(restCh)
+		for v := range in {
+			if pred(v) {
+				matchingCh <- v
+			} else {
+				restCh <- v
+			}
+		}
+	}()
+	return matchingCh, restCh
+}
+
+// Tee returns a number of channels that each receive all values from the
+// input channel, also known as broadcasting. All output channels are closed
+// when the input channel is closed.
+//
+// Each value is sent to the output channels one at a time, so all output
+// channels must be consumed, as a slow consumer blocks all the other
+// consumers from receiving any further values. Use TeeBuf to allow consumers
+// to fall behind by a number of values.
+func Tee[C Receiver[V], V any](in C, outCount int) []<-chan V {
+	return TeeBuf(in, outCount, 0)
+}
+
+// TeeBuf returns a number of buffered channels that each receive all values
+// from the input channel. This is the same as Tee, but where each output
+// channel has a buffer of the given size, allowing a slow consumer to fall
+// behind by that many values before it blocks the other consumers.
+func TeeBuf[C Receiver[V], V any](in C, outCount, buffer int) []<-chan V {
+	chans := make([]chan V, outCount)
+	outs := make([]<-chan V, outCount)
+	for i := range chans {
+		chans[i] = make(chan V, buffer)
+		outs[i] = chans[i]
+	}
+	go func() {
+		defer func() {
+			for _, ch := range chans {
+				close(ch)
+			}
+		}()
+		for v := range in {
+			for _, ch := range chans {
+				ch <- v
+			}
+		}
+	}()
+	return outs
+}
+
+// RateLimit returns a channel that receives all values from the input channel,
+// but limited by a token bucket. Up to burst number of values may be sent
+// right away, after which the values are sent at a rate of the given number of
+// values per second. The output channel is closed when the input channel is
+// closed.
+//
+// Will panic if the rate is not positive. A burst below 1 is treated as 1.
+func RateLimit[C Receiver[V], V any](in C, rate float64, burst int) <-chan V {
+	if rate <= 0 {
+		panic("chans.RateLimit: rate must be positive")
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	out := make(chan V)
+	go func() {
+		defer close(out)
+		tokens := float64(burst)
+		last := time.Now()
+		for v := range in {
+			now := time.Now()
+			tokens = math.Min(float64(burst), tokens+now.Sub(last).Seconds()*rate)
+			last = now
+			if tokens < 1 {
+				time.Sleep(time.Duration((1 - tokens) / rate * float64(time.Second)))
+				tokens = 1
+				last = time.Now()
+			}
+			tokens--
+			out <- v
+		}
+	}()
+	return out
+}
+
+// Flatten returns a channel that receives each element from the slices
+// received from the input channel, one by one. Empty slices are skipped.
+// The output channel is closed when the input channel is closed.
+func Flatten[C Receiver[S], S ~[]V, V any](in C) <-chan V {
+	out := make(chan V)
+	go func() {
+		defer close(out)
+		for slice := range in {
+			for _, v := range slice {
+				out <- v
+			}
+		}
+	}()
+	return out
+}
+
+// Map returns a channel that receives all values from the input channel after
+// they have been converted using the conversion function. The output channel
+// is closed when the input channel is closed.
+func Map[C Receiver[V], V, Result any](in C, conv func(value V) Result) <-chan Result {
+	return MapBuf(in, 0, conv)
+}
+
+// MapBuf returns a buffered channel that receives all values from the input
+// channel after they have been converted using the conversion function. This
+// is the same as Map, but where the output channel has a buffer of the given
+// size.
+func MapBuf[C Receiver[V], V, Result any](in C, buffer int, conv func(value V) Result) <-chan Result {
+	out := make(chan Result, buffer)
+	go func() {
+		defer close(out)
+		for v := range in {
+			out <- conv(v)
+		}
+	}()
+	return out
+}
+
+// Filter returns a channel that only receives the values from the input
+// channel where the predicate returns true. The output channel is closed when
+// the input channel is closed. Will panic if the predicate is nil.
+func Filter[C Receiver[V], V any](in C, pred func(value V) bool) <-chan V {
+	if pred == nil {
+		panic("chans.Filter: predicate must not be nil")
+	}
+	out := make(chan V)
+	go func() {
+		defer close(out)
+		for v := range in {
+			if pred(v) {
+				out <- v
+			}
+		}
+	}()
+	return out
+}
+
+// Tap returns a channel that receives all values from the input channel as-is,
+// while also invoking the function on each value before it is sent. This is
+// useful for observing values passing through a pipeline, such as for logging.
+// The output channel is closed when the input channel is closed.
+func Tap[C Receiver[V], V any](in C, f func(value V)) <-chan V {
+	out := make(chan V)
+	go func() {
+		defer close(out)
+		for v := range in {
+			f(v)
+			out <- v
+		}
+	}()
+	return out
+}
+
+// Merge returns a channel that receives all values from all of the input
+// channels, also known as fan-in. The input channels are read concurrently,
+// so the order of values is only preserved per input channel. Any nil
+// channels are skipped. The output channel is closed once all input channels
+// are closed.
+func Merge[C Receiver[V], V any](chans ...C) <-chan V {
+	out := make(chan V)
+	var wg sync.WaitGroup
+	for _, ch := range chans {
+		if ch == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(ch C) {
+			defer wg.Done()
+			for v := range ch {
+				out <- v
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Unique returns a channel that only receives the first occurrence of each
+// value from the input channel, dropping any later duplicates. The output
+// channel is closed when the input channel is closed.
+//
+// All values that have been seen are kept in memory until the input channel
+// is closed, so memory usage grows unbounded with the number of distinct
+// values. Avoid using this on high-cardinality streams.
+func Unique[C Receiver[V], V comparable](in C) <-chan V {
+	out := make(chan V)
+	go func() {
+		defer close(out)
+		seen := make(map[V]struct{})
+		for v := range in {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			out <- v
+		}
+	}()
+	return out
+}
+
+// Zip returns a channel that receives pairs of values, one from each of the
+// input channels. The output channel is closed when either of the input
+// channels is closed.
+//
+// A pair is only sent once a value has been received from both channels, so
+// if one channel produces values faster than the other, then it will be
+// blocked while waiting for the slower channel.
+func Zip[CA Receiver[A], CB Receiver[B], A, B any](a CA, b CB) <-chan typ.Pair[A, B] {
+	out := make(chan typ.Pair[A, B])
+	go func() {
+		defer close(out)
+		for {
+			valueA, ok := <-a
+			if !ok {
+				return
+			}
+			valueB, ok := <-b
+			if !ok {
+				return
+			}
+			out <- typ.Pair[A, B]{A: valueA, B: valueB}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,457 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/typ.v4"
+)
+
+func TestGenerate(t *testing.T) {
+	assertValues(t, []int{1, 2, 3}, recvAll(Generate([]int{1, 2, 3})))
+	assertValues(t, []int{}, recvAll(Generate([]int{})))
+}
+
+func TestGenerateCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := GenerateCtx(ctx, []int{1, 2, 3, 4, 5})
+
+	if v, ok := RecvTimeout(out, time.Second); !ok || v != 1 {
+		t.Fatalf("want 1, got %d (ok=%t)", v, ok)
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		recvAll(out)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("want channel to be closed after cancel, but it was not")
+	}
+}
+
+func TestBuffer(t *testing.T) {
+	const count = 100
+	in := make(chan int)
+	out := Buffer(in)
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < count; i++ {
+			in <- i
+		}
+		close(in)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("producer was blocked by the consumer")
+	}
+
+	got := recvAll(out)
+	if len(got) != count {
+		t.Fatalf("want len=%d, got len=%d", count, len(got))
+	}
+	for i, v := range got {
+		if v != i {
+			t.Errorf("index %d: want %d, got %d", i, i, v)
+		}
+	}
+}
+
+func TestWithHeartbeat(t *testing.T) {
+	const beat = -1
+	in := make(chan int)
+	out := WithHeartbeat(in, 10*time.Millisecond, beat)
+
+	if v, ok := RecvTimeout(out, time.Second); !ok || v != beat {
+		t.Fatalf("want heartbeat %d while idle, got %d (ok=%t)", beat, v, ok)
+	}
+
+	go func() { in <- 42 }()
+	deadline := time.After(time.Second)
+	for found := false; !found; {
+		select {
+		case v := <-out:
+			found = v == 42
+		case <-deadline:
+			t.Fatal("timed out waiting for data value")
+		}
+	}
+
+	close(in)
+	recvAll(out)
+}
+
+func TestWithHeartbeatNonPositiveInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("want panic for interval %v, got none", interval)
+				}
+			}()
+			WithHeartbeat(make(chan int), interval, -1)
+		}()
+	}
+}
+
+func TestIdleTimeoutSteady(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		for i := 1; i <= 5; i++ {
+			time.Sleep(5 * time.Millisecond)
+			in <- i
+		}
+		close(in)
+	}()
+	out, timedOut := IdleTimeout(in, time.Second)
+	assertValues(t, []int{1, 2, 3, 4, 5}, recvAll(out))
+	select {
+	case <-timedOut:
+		t.Error("want no timeout for steady stream, but timed out")
+	default:
+	}
+}
+
+func TestIdleTimeoutStalled(t *testing.T) {
+	in := make(chan int, 1)
+	in <- 1
+	out, timedOut := IdleTimeout(in, 20*time.Millisecond)
+
+	assertValues(t, []int{1}, recvAll(out))
+	select {
+	case <-timedOut:
+	case <-time.After(time.Second):
+		t.Fatal("want timeout for stalled stream, but did not time out")
+	}
+}
+
+func TestBatchBySize(t *testing.T) {
+	in := make(chan int, 7)
+	for i := 1; i <= 7; i++ {
+		in <- i
+	}
+	close(in)
+
+	got := recvAll(Batch(in, 3, 0))
+	want := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if len(got) != len(want) {
+		t.Fatalf("want %v, got %v", want, got)
+	}
+	for i := range want {
+		assertValues(t, want[i], got[i])
+	}
+}
+
+func TestBatchByTime(t *testing.T) {
+	in := make(chan int)
+	out := Batch(in, 10, 20*time.Millisecond)
+
+	in <- 1
+	in <- 2
+	batch, ok := RecvTimeout(out, time.Second)
+	if !ok {
+		t.Fatal("want batch after max wait, got none")
+	}
+	assertValues(t, []int{1, 2}, batch)
+
+	in <- 3
+	close(in)
+	got := recvAll(out)
+	if len(got) != 1 {
+		t.Fatalf("want single final batch, got %v", got)
+	}
+	assertValues(t, []int{3}, got[0])
+}
+
+func TestBatchNoEmpty(t *testing.T) {
+	in := make(chan int)
+	out := Batch(in, 2, 5*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	close(in)
+	if got := recvAll(out); len(got) != 0 {
+		t.Errorf("want no batches, got %v", got)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		for i := 1; i <= 6; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+	even, odd := Split(in, func(value int) bool {
+		return value%2 == 0
+	})
+
+	var gotEven, gotOdd []int
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		gotEven = recvAll(even)
+		wg.Done()
+	}()
+	go func() {
+		gotOdd = recvAll(odd)
+		wg.Done()
+	}()
+	wg.Wait()
+
+	assertValues(t, []int{2, 4, 6}, gotEven)
+	assertValues(t, []int{1, 3, 5}, gotOdd)
+}
+
+func TestTee(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+	outs := Tee(in, 3)
+	if len(outs) != 3 {
+		t.Fatalf("want 3 channels, got %d", len(outs))
+	}
+
+	got := make([][]int, len(outs))
+	var wg sync.WaitGroup
+	wg.Add(len(outs))
+	for i, out := range outs {
+		go func(i int, out <-chan int) {
+			got[i] = recvAll(out)
+			wg.Done()
+		}(i, out)
+	}
+	wg.Wait()
+
+	for i := range outs {
+		assertValues(t, []int{1, 2, 3}, got[i])
+	}
+}
+
+func TestTeeBuf(t *testing.T) {
+	in := make(chan int, 3)
+	for i := 1; i <= 3; i++ {
+		in <- i
+	}
+	close(in)
+	outs := TeeBuf(in, 2, 3)
+
+	// Consuming the channels one after the other only works thanks to the
+	// buffer, as the unbuffered Tee would block on the second channel.
+	assertValues(t, []int{1, 2, 3}, recvAll(outs[0]))
+	assertValues(t, []int{1, 2, 3}, recvAll(outs[1]))
+}
+
+func TestRateLimit(t *testing.T) {
+	const interval = 50 * time.Millisecond
+	in := make(chan int, 5)
+	for i := 0; i < 5; i++ {
+		in <- i
+	}
+	close(in)
+
+	start := time.Now()
+	out := RateLimit(in, float64(time.Second/interval), 3)
+	var elapsed []time.Duration
+	for range out {
+		elapsed = append(elapsed, time.Since(start))
+	}
+	if len(elapsed) != 5 {
+		t.Fatalf("want 5 values, got %d", len(elapsed))
+	}
+	for i := 0; i < 3; i++ {
+		if elapsed[i] >= interval {
+			t.Errorf("value %d: want burst before %s, got %s", i, interval, elapsed[i])
+		}
+	}
+	for i := 3; i < 5; i++ {
+		min := time.Duration(i-2) * interval * 9 / 10
+		if elapsed[i] < min {
+			t.Errorf("value %d: want rate limited to after %s, got %s", i, min, elapsed[i])
+		}
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	in := make(chan []int, 4)
+	in <- []int{1, 2}
+	in <- []int{}
+	in <- nil
+	in <- []int{3, 4, 5}
+	close(in)
+
+	got := recvAll(Flatten(in))
+	assertValues(t, []int{1, 2, 3, 4, 5}, got)
+}
+
+func TestMap(t *testing.T) {
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	in <- 3
+	close(in)
+
+	got := recvAll(Map(in, func(value int) string {
+		return fmt.Sprint(value * 10)
+	}))
+	assertValues(t, []string{"10", "20", "30"}, got)
+}
+
+func TestMapBuf(t *testing.T) {
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	in <- 3
+	close(in)
+
+	out := MapBuf(in, 3, func(value int) int {
+		return value * 2
+	})
+	if cap(out) != 3 {
+		t.Errorf("want cap=3, got cap=%d", cap(out))
+	}
+	assertValues(t, []int{2, 4, 6}, recvAll(out))
+}
+
+func TestFilter(t *testing.T) {
+	in := make(chan int, 6)
+	for i := 1; i <= 6; i++ {
+		in <- i
+	}
+	close(in)
+
+	got := recvAll(Filter(in, func(value int) bool {
+		return value%2 == 0
+	}))
+	assertValues(t, []int{2, 4, 6}, got)
+}
+
+func TestFilterNilPredicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("want panic on nil predicate, got none")
+		}
+	}()
+	Filter(make(chan int), nil)
+}
+
+func TestTap(t *testing.T) {
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	in <- 3
+	close(in)
+
+	var tapped []int
+	got := recvAll(Tap(in, func(value int) {
+		tapped = append(tapped, value)
+	}))
+	assertValues(t, []int{1, 2, 3}, got)
+	assertValues(t, []int{1, 2, 3}, tapped)
+}
+
+func TestMerge(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+	go func() {
+		for _, v := range []int{1, 2, 3} {
+			a <- v
+		}
+		close(a)
+	}()
+	go func() {
+		for _, v := range []int{4, 5} {
+			b <- v
+		}
+		close(b)
+	}()
+
+	got := recvAll(Merge(a, nil, b))
+	if len(got) != 5 {
+		t.Fatalf("want 5 values, got %v", got)
+	}
+	seen := map[int]bool{}
+	for _, v := range got {
+		seen[v] = true
+	}
+	for v := 1; v <= 5; v++ {
+		if !seen[v] {
+			t.Errorf("want value %d in %v", v, got)
+		}
+	}
+}
+
+func TestMergeNoChannels(t *testing.T) {
+	if got := recvAll(Merge[chan int]()); len(got) != 0 {
+		t.Errorf("want no values, got %v", got)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	in := make(chan int, 5)
+	for _, v := range []int{1, 2, 1, 3, 2} {
+		in <- v
+	}
+	close(in)
+
+	assertValues(t, []int{1, 2, 3}, recvAll(Unique(in)))
+}
+
+func TestZip(t *testing.T) {
+	a := make(chan int, 3)
+	b := make(chan string, 3)
+	for i, s := range []string{"a", "b", "c"} {
+		a <- i
+		b <- s
+	}
+	close(a)
+	close(b)
+
+	got := recvAll(Zip(a, b))
+	want := []typ.Pair[int, string]{
+		typ.NewPair(0, "a"),
+		typ.NewPair(1, "b"),
+		typ.NewPair(2, "c"),
+	}
+	assertValues(t, want, got)
+}
+
+func TestZipEarlyClose(t *testing.T) {
+	a := make(chan int, 3)
+	b := make(chan string, 3)
+	a <- 0
+	a <- 1
+	a <- 2
+	close(a)
+	b <- "a"
+	close(b)
+
+	got := recvAll(Zip(a, b))
+	want := []typ.Pair[int, string]{typ.NewPair(0, "a")}
+	assertValues(t, want, got)
+}
+
+func recvAll[V any](ch <-chan V) []V {
+	var values []V
+	for v := range ch {
+		values = append(values, v)
+	}
+	return values
+}
@@ -5,6 +5,7 @@
 package chans
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -19,7 +20,7 @@ var (
 // PubSub is a type that allows publishing an event which will be sent out
 // to all subscribed channels. A sort of "fan-out message queue".
 type PubSub[T any] struct {
-	OnPubTimeout    func(ev T)    // called if Pub or PubWait times out
+	OnPubTimeout    func(ev T)    // called if Pub or PubWait times out, or PubContext is cancelled
 	PubTimeoutAfter time.Duration // times out Pub & PubWait, if positive
 	DefaultBuffer   int
 
@@ -63,6 +64,23 @@ func (o *PubSub[T]) PubWait(ev T) {
 	wg.Wait()
 }
 
+// PubContext blocks while sending the event to all subscriptions in their own
+// goroutines, and waits until all have received the message or the context is
+// cancelled. The OnPubTimeout callback is called for each subscription that
+// did not receive the event due to the context being cancelled.
+//
+// This differs from PubWait as it does not use the PubTimeoutAfter duration.
+func (o *PubSub[T]) PubContext(ctx context.Context, ev T) {
+	var wg sync.WaitGroup
+	o.mutex.RLock()
+	wg.Add(len(o.subs))
+	for _, sub := range o.subs {
+		go o.sendContextWaitGroup(ctx, ev, sub, o.OnPubTimeout, &wg)
+	}
+	o.mutex.RUnlock()
+	wg.Wait()
+}
+
 // PubSliceWait blocks while sending a slice of events to all subscriptions in
 // their own goroutines, and waits until all have received the message or
 // timed out.
@@ -115,6 +133,13 @@ func (o *PubSub[T]) sendWaitGroup(ev T, sub chan T, timeout time.Duration, onTim
 	wg.Done()
 }
 
+func (o *PubSub[T]) sendContextWaitGroup(ctx context.Context, ev T, sub chan T, onTimeout func(T), wg *sync.WaitGroup) {
+	if !SendContext(ctx, sub, ev) && onTimeout != nil {
+		onTimeout(ev)
+	}
+	wg.Done()
+}
+
 // WithOnly returns a new publisher that only contains the given subscription
 // channel. Useful if you need to send events only to a single specific
 // subscription.
@@ -153,6 +178,40 @@ func (o *PubSub[T]) SubBuf(size int) <-chan T {
 	return sub
 }
 
+// SubFunc subscribes to events using a callback function, which is invoked
+// for every event from a newly created goroutine. The returned function
+// unsubscribes, which stops the goroutine, and is safe to call multiple times.
+// The goroutine also stops if the subscription is unsubscribed by UnsubAll.
+//
+// The subscription channel uses the default buffer size for this PubSub.
+func (o *PubSub[T]) SubFunc(handler func(ev T)) (unsub func()) {
+	sub := o.Sub()
+	go func() {
+		for ev := range sub {
+			handler(ev)
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			o.Unsub(sub)
+		})
+	}
+}
+
+// NumSubs returns the number of active subscriptions.
+func (o *PubSub[T]) NumSubs() int {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	return len(o.subs)
+}
+
+// HasSubs returns true if there are any active subscriptions. Useful to skip
+// constructing an event when there is no one to publish it to.
+func (o *PubSub[T]) HasSubs() bool {
+	return o.NumSubs() > 0
+}
+
 // Unsub unsubscribes a previously subscribed channel.
 func (o *PubSub[T]) Unsub(sub <-chan T) error {
 	if sub == nil {
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPubSubSubFunc(t *testing.T) {
+	var pub PubSub[int]
+	received := make(chan int, 3)
+	unsub := pub.SubFunc(func(ev int) {
+		received <- ev
+	})
+
+	pub.PubWait(1)
+	pub.PubWait(2)
+	assertValues(t, []int{1, 2}, recvN(t, received, 2))
+
+	unsub()
+	unsub()
+	pub.PubWait(3)
+	if got := RecvQueued(received, 1); len(got) != 0 {
+		t.Errorf("want no events after unsub, got %v", got)
+	}
+}
+
+func TestPubSubSubFuncStopsOnUnsub(t *testing.T) {
+	var pub PubSub[int]
+	received := make(chan int, 1)
+	unsub := pub.SubFunc(func(ev int) {
+		received <- ev
+	})
+	unsub()
+	assertHandlerStopped(t, &pub, received)
+}
+
+func TestPubSubSubFuncStopsOnUnsubAll(t *testing.T) {
+	var pub PubSub[int]
+	received := make(chan int, 1)
+	unsub := pub.SubFunc(func(ev int) {
+		received <- ev
+	})
+	pub.UnsubAll()
+	assertHandlerStopped(t, &pub, received)
+	unsub()
+}
+
+func assertHandlerStopped(t *testing.T, pub *PubSub[int], received <-chan int) {
+	t.Helper()
+	if n := pub.NumSubs(); n != 0 {
+		t.Errorf("want 0 subs, got %d", n)
+	}
+	pub.PubWait(1)
+	select {
+	case ev := <-received:
+		t.Errorf("want handler to not be called, got event %d", ev)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestPubSubNumSubs(t *testing.T) {
+	var pub PubSub[int]
+	if n := pub.NumSubs(); n != 0 {
+		t.Errorf("want 0 subs, got %d", n)
+	}
+	if pub.HasSubs() {
+		t.Error("want HasSubs()==false, got true")
+	}
+
+	sub1 := pub.Sub()
+	pub.SubBuf(1)
+	if n := pub.NumSubs(); n != 2 {
+		t.Errorf("want 2 subs, got %d", n)
+	}
+	if !pub.HasSubs() {
+		t.Error("want HasSubs()==true, got false")
+	}
+
+	pub.Unsub(sub1)
+	if n := pub.NumSubs(); n != 1 {
+		t.Errorf("want 1 sub after Unsub, got %d", n)
+	}
+	pub.UnsubAll()
+	if n := pub.NumSubs(); n != 0 {
+		t.Errorf("want 0 subs after UnsubAll, got %d", n)
+	}
+}
+
+func TestPubSubPubContext(t *testing.T) {
+	var pub PubSub[int]
+	sub := pub.SubBuf(1)
+	pub.PubContext(context.Background(), 1)
+	assertValues(t, []int{1}, RecvQueued(sub, 2))
+}
+
+func TestPubSubPubContextCancelled(t *testing.T) {
+	aborted := make(chan int, 2)
+	pub := PubSub[int]{
+		OnPubTimeout: func(ev int) {
+			aborted <- ev
+		},
+	}
+	received := pub.SubBuf(1)
+	pub.Sub() // never received from
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	pub.PubContext(ctx, 1)
+
+	assertValues(t, []int{1}, RecvQueued(received, 2))
+	assertValues(t, []int{1}, RecvQueued(aborted, 2))
+}
+
+func recvN[V any](t *testing.T, ch <-chan V, n int) []V {
+	t.Helper()
+	values, ok := RecvN(ch, n, time.Second)
+	if !ok {
+		t.Fatalf("want %d values, got %d: %v", n, len(values), values)
+	}
+	return values
+}
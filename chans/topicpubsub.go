@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"sync"
+	"time"
+)
+
+// TopicPubSub is a type that allows publishing an event to a topic, which
+// will be sent out to all channels subscribed to that same topic. Each topic
+// is handled by its own PubSub, which is created on the first subscription to
+// that topic, and removed when its last subscription is unsubscribed.
+//
+// The configuration fields are shared by all topics, and are copied into each
+// topic's PubSub when it is created. They should therefore be set before
+// subscribing to any topic.
+type TopicPubSub[K comparable, T any] struct {
+	OnPubTimeout    func(ev T)    // called if Pub or PubWait times out
+	PubTimeoutAfter time.Duration // times out Pub & PubWait, if positive
+	DefaultBuffer   int
+
+	topics map[K]*PubSub[T]
+	mutex  sync.RWMutex
+}
+
+// Pub sends the event to all subscriptions of the topic in their own
+// goroutines and returns immediately without waiting for any of the channels
+// to finish sending.
+func (o *TopicPubSub[K, T]) Pub(topic K, ev T) {
+	if pubSub := o.topic(topic); pubSub != nil {
+		pubSub.Pub(ev)
+	}
+}
+
+// PubWait blocks while sending the event to all subscriptions of the topic in
+// their own goroutines, and waits until all have received the message or
+// timed out.
+func (o *TopicPubSub[K, T]) PubWait(topic K, ev T) {
+	if pubSub := o.topic(topic); pubSub != nil {
+		pubSub.PubWait(ev)
+	}
+}
+
+// Sub subscribes to events on the topic in a newly created channel using the
+// default buffer size for this TopicPubSub. If no default is configured, the
+// buffer size will be 0.
+func (o *TopicPubSub[K, T]) Sub(topic K) <-chan T {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	pubSub, ok := o.topics[topic]
+	if !ok {
+		pubSub = &PubSub[T]{
+			OnPubTimeout:    o.OnPubTimeout,
+			PubTimeoutAfter: o.PubTimeoutAfter,
+			DefaultBuffer:   o.DefaultBuffer,
+		}
+		if o.topics == nil {
+			o.topics = make(map[K]*PubSub[T])
+		}
+		o.topics[topic] = pubSub
+	}
+	return pubSub.Sub()
+}
+
+// Unsub unsubscribes a previously subscribed channel from the topic. The topic
+// is removed once its last subscription has been unsubscribed.
+func (o *TopicPubSub[K, T]) Unsub(topic K, sub <-chan T) error {
+	if sub == nil {
+		return ErrSubscriptionNotInitalized
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	pubSub, ok := o.topics[topic]
+	if !ok {
+		return ErrAlreadyUnsubscribed
+	}
+	if err := pubSub.Unsub(sub); err != nil {
+		return err
+	}
+	if !pubSub.HasSubs() {
+		delete(o.topics, topic)
+	}
+	return nil
+}
+
+// NumTopics returns the number of topics that currently have any subscriptions.
+func (o *TopicPubSub[K, T]) NumTopics() int {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	return len(o.topics)
+}
+
+func (o *TopicPubSub[K, T]) topic(topic K) *PubSub[T] {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	return o.topics[topic]
+}
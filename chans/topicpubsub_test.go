@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTopicPubSub(t *testing.T) {
+	pub := TopicPubSub[string, int]{DefaultBuffer: 2}
+	subA1 := pub.Sub("a")
+	subA2 := pub.Sub("a")
+	subB := pub.Sub("b")
+
+	pub.PubWait("a", 1)
+	pub.PubWait("b", 2)
+	pub.PubWait("c", 3)
+
+	assertValues(t, []int{1}, RecvQueued(subA1, 2))
+	assertValues(t, []int{1}, RecvQueued(subA2, 2))
+	assertValues(t, []int{2}, RecvQueued(subB, 2))
+}
+
+func TestTopicPubSubCleansUpTopics(t *testing.T) {
+	var pub TopicPubSub[string, int]
+	sub1 := pub.Sub("a")
+	sub2 := pub.Sub("a")
+	if n := pub.NumTopics(); n != 1 {
+		t.Fatalf("want 1 topic, got %d", n)
+	}
+
+	if err := pub.Unsub("a", sub1); err != nil {
+		t.Fatal(err)
+	}
+	if n := pub.NumTopics(); n != 1 {
+		t.Errorf("want 1 topic after first unsub, got %d", n)
+	}
+	if err := pub.Unsub("a", sub2); err != nil {
+		t.Fatal(err)
+	}
+	if n := pub.NumTopics(); n != 0 {
+		t.Errorf("want 0 topics after last unsub, got %d", n)
+	}
+	if err := pub.Unsub("a", sub2); !errors.Is(err, ErrAlreadyUnsubscribed) {
+		t.Errorf("want ErrAlreadyUnsubscribed, got %v", err)
+	}
+
+	// Publishing to a removed topic is a no-op.
+	pub.PubWait("a", 1)
+}
+
+func TestTopicPubSubSharedConfig(t *testing.T) {
+	timedOut := make(chan int, 2)
+	pub := TopicPubSub[string, int]{
+		PubTimeoutAfter: 10 * time.Millisecond,
+		OnPubTimeout: func(ev int) {
+			timedOut <- ev
+		},
+	}
+	pub.Sub("a")
+	pub.Sub("b")
+
+	pub.PubWait("a", 1)
+	pub.PubWait("b", 2)
+	assertValues(t, []int{1, 2}, RecvQueued(timedOut, 2))
+}
@@ -55,6 +55,24 @@ func HasKey[M ~map[K]V, K comparable, V any](m M, key K) bool {
 	return ok
 }
 
+// GetOr returns the value on the given key, or the fallback value if the map
+// does not have a value on the given key.
+func GetOr[M ~map[K]V, K comparable, V any](m M, key K, fallback V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return fallback
+}
+
+// GetOrZero returns the value on the given key, or the zero value for the
+// type if the map does not have a value on the given key.
+//
+// This is the same as indexing the map directly, and is mostly useful when
+// a function is wanted, such as when passing it as an argument.
+func GetOrZero[M ~map[K]V, K comparable, V any](m M, key K) V {
+	return m[key]
+}
+
 // Keys returns a slice of all the keys in this map. The order of the
 // keys is arbitrary.
 func Keys[M ~map[K]V, K comparable, V any](m M) []K {
@@ -74,3 +92,39 @@ func Values[M ~map[K]V, K comparable, V any](m M) []V {
 	}
 	return values
 }
+
+// MaxByValue returns the key and value of the largest value in the map, or
+// false if the map is empty. If multiple keys share the largest value, then
+// which of those keys is returned is unspecified, as the iteration order of
+// maps is arbitrary.
+func MaxByValue[M ~map[K]V, K comparable, V typ.Ordered](m M) (K, V, bool) {
+	var (
+		maxKey   K
+		maxValue V
+		found    bool
+	)
+	for k, v := range m {
+		if !found || v > maxValue {
+			maxKey, maxValue, found = k, v, true
+		}
+	}
+	return maxKey, maxValue, found
+}
+
+// MinByValue returns the key and value of the smallest value in the map, or
+// false if the map is empty. If multiple keys share the smallest value, then
+// which of those keys is returned is unspecified, as the iteration order of
+// maps is arbitrary.
+func MinByValue[M ~map[K]V, K comparable, V typ.Ordered](m M) (K, V, bool) {
+	var (
+		minKey   K
+		minValue V
+		found    bool
+	)
+	for k, v := range m {
+		if !found || v < minValue {
+			minKey, minValue, found = k, v, true
+		}
+	}
+	return minKey, minValue, found
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package maps_test
+
+import (
+	"testing"
+
+	"gopkg.in/typ.v4/internal/assert"
+	"gopkg.in/typ.v4/maps"
+)
+
+func TestGetOr(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	assert.Comparable(t, "present", 1, maps.GetOr(m, "a", 42))
+	assert.Comparable(t, "present zero", 0, maps.GetOr(m, "zero", 42))
+	assert.Comparable(t, "absent", 42, maps.GetOr(m, "b", 42))
+}
+
+func TestGetOrZero(t *testing.T) {
+	m := map[string]int{"a": 1}
+	assert.Comparable(t, "present", 1, maps.GetOrZero(m, "a"))
+	assert.Comparable(t, "absent", 0, maps.GetOrZero(m, "b"))
+}
+
+func TestMaxByValue(t *testing.T) {
+	m := map[string]int{"a": 3, "b": 7, "c": -2, "d": 5}
+	key, value, ok := maps.MaxByValue(m)
+	assert.Comparable(t, "ok", true, ok)
+	assert.Comparable(t, "key", "b", key)
+	assert.Comparable(t, "value", 7, value)
+
+	_, _, ok = maps.MaxByValue(map[string]int{})
+	assert.Comparable(t, "empty ok", false, ok)
+}
+
+func TestMinByValue(t *testing.T) {
+	m := map[string]int{"a": 3, "b": 7, "c": -2, "d": 5}
+	key, value, ok := maps.MinByValue(m)
+	assert.Comparable(t, "ok", true, ok)
+	assert.Comparable(t, "key", "c", key)
+	assert.Comparable(t, "value", -2, value)
+
+	_, _, ok = maps.MinByValue(map[string]int(nil))
+	assert.Comparable(t, "nil ok", false, ok)
+}
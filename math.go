@@ -4,6 +4,12 @@
 
 package typ
 
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
 // Min returns the smallest value.
 func Min[T Ordered](v ...T) T {
 	switch len(v) {
@@ -41,6 +47,8 @@ func Max[T Ordered](v ...T) T {
 }
 
 // Clamp returns the value clamped between the minimum and maximum values.
+// This works for any type with an ordered underlying type, such as
+// time.Duration.
 func Clamp[T Ordered](v, min, max T) T {
 	if v < min {
 		return min
@@ -51,6 +59,42 @@ func Clamp[T Ordered](v, min, max T) T {
 	return v
 }
 
+// Wrap returns the value wrapped into the range [min, max), where values
+// outside the range wrap around to the other side. This is useful for cyclic
+// values, such as angles or indices. Will panic if max is not greater than
+// min.
+// 	Wrap(370, 0, 360) // => 10
+// 	Wrap(-10, 0, 360) // => 350
+func Wrap[T Integer](v, min, max T) T {
+	if max <= min {
+		panic("typ.Wrap: max must be greater than min")
+	}
+	// Computed in uint64, as the range and offsets may overflow narrower
+	// types, such as Wrap[int8](0, -100, 100) with a range size of 200.
+	// Converting to uint64 sign-extends signed values, so the differences
+	// below are correct modulo 2^64, and converting the result back to T
+	// truncates it into the range.
+	size := uint64(max) - uint64(min)
+	if v >= min {
+		return T(uint64(min) + (uint64(v)-uint64(min))%size)
+	}
+	offset := (uint64(min) - uint64(v)) % size
+	if offset == 0 {
+		return min
+	}
+	return T(uint64(max) - offset)
+}
+
+// ValidateRange returns an error if the value is not between the minimum and
+// maximum values, inclusive. The name is used in the error message.
+// 	ValidateRange("age", 200, 0, 150) // => error: age must be between 0 and 150, got 200
+func ValidateRange[T Ordered](name string, v, min, max T) error {
+	if v < min || v > max {
+		return fmt.Errorf("%s must be between %v and %v, got %v", name, min, max, v)
+	}
+	return nil
+}
+
 // Clamp01 returns the value clamped between 0 (zero) and 1 (one).
 func Clamp01[T Real](v T) T {
 	if v < 0 {
@@ -62,6 +106,13 @@ func Clamp01[T Real](v T) T {
 	return v
 }
 
+// LerpDuration performs a linear interpolation between two durations, where
+// t=0 results in a, and t=1 results in b. The t value is not clamped, so values
+// outside the range of 0-1 will extrapolate beyond a and b.
+func LerpDuration(a, b time.Duration, t float64) time.Duration {
+	return a + time.Duration(float64(b-a)*t)
+}
+
 // Sum adds upp all numbers from the arguments. Returns 0 if no arguments.
 func Sum[T Number](v ...T) T {
 	var sum T
@@ -87,6 +138,7 @@ func Product[T Number](v ...T) T {
 // 	Abs(0)   // => 0
 // 	Abs(15)  // => 15
 // 	Abs(-15) // => 15
+// This only works on real numbers. See Magnitude for complex numbers.
 func Abs[T Real](v T) T {
 	if v < 0 {
 		return -v
@@ -94,6 +146,15 @@ func Abs[T Real](v T) T {
 	return v
 }
 
+// Magnitude returns the absolute value of a complex number, also known as its
+// modulus, which is its distance from zero in the complex plane.
+// 	Magnitude(3+4i)  // => 5
+// 	Magnitude(-3-4i) // => 5
+func Magnitude[T Complex](v T) float64 {
+	c := complex128(v)
+	return math.Hypot(real(c), imag(c))
+}
+
 // DigitsSign10 returns the number of digits in the number as if it would be
 // converted to a string in base 10, plus 1 if the number is negative to account
 // for the negative sign. This is computed by comparing its value to all orders
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package typ
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestClampDuration(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value time.Duration
+		want  time.Duration
+	}{
+		{name: "below", value: time.Millisecond, want: time.Second},
+		{name: "min", value: time.Second, want: time.Second},
+		{name: "within", value: 3 * time.Second, want: 3 * time.Second},
+		{name: "max", value: 5 * time.Second, want: 5 * time.Second},
+		{name: "above", value: time.Minute, want: 5 * time.Second},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Clamp(tc.value, time.Second, 5*time.Second)
+			if got != tc.want {
+				t.Errorf("want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLerpDuration(t *testing.T) {
+	testCases := []struct {
+		name string
+		t    float64
+		want time.Duration
+	}{
+		{name: "start", t: 0, want: time.Second},
+		{name: "middle", t: 0.5, want: 2 * time.Second},
+		{name: "end", t: 1, want: 3 * time.Second},
+		{name: "extrapolate", t: 2, want: 5 * time.Second},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := LerpDuration(time.Second, 3*time.Second, tc.t)
+			if got != tc.want {
+				t.Errorf("want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMagnitude(t *testing.T) {
+	if got := Magnitude(complex128(3 + 4i)); got != 5 {
+		t.Errorf("complex128: want 5, got %v", got)
+	}
+	if got := Magnitude(complex64(-3 - 4i)); got != 5 {
+		t.Errorf("complex64: want 5, got %v", got)
+	}
+	if got := Magnitude(complex128(0)); got != 0 {
+		t.Errorf("zero: want 0, got %v", got)
+	}
+}
+
+func TestValidateRange(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   int
+		wantErr string
+	}{
+		{name: "within", value: 42},
+		{name: "min", value: 0},
+		{name: "max", value: 150},
+		{name: "below", value: -1, wantErr: "age must be between 0 and 150, got -1"},
+		{name: "above", value: 200, wantErr: "age must be between 0 and 150, got 200"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRange("age", tc.value, 0, 150)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("want nil error, got %q", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("want error %q, got nil", tc.wantErr)
+			}
+			if err.Error() != tc.wantErr {
+				t.Errorf("want error %q, got %q", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value int
+		want  int
+	}{
+		{name: "within", value: 90, want: 90},
+		{name: "min", value: 0, want: 0},
+		{name: "max", value: 360, want: 0},
+		{name: "above", value: 370, want: 10},
+		{name: "above multiple wraps", value: 1090, want: 10},
+		{name: "below", value: -10, want: 350},
+		{name: "below multiple wraps", value: -730, want: 350},
+		{name: "below exact", value: -720, want: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Wrap(tc.value, 0, 360)
+			if got != tc.want {
+				t.Errorf("want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWrapOffsetRange(t *testing.T) {
+	if got := Wrap(0, 5, 10); got != 5 {
+		t.Errorf("signed: want 5, got %d", got)
+	}
+	if got := Wrap(uint(1), 5, 10); got != 6 {
+		t.Errorf("unsigned below: want 6, got %d", got)
+	}
+	if got := Wrap(uint(12), 5, 10); got != 7 {
+		t.Errorf("unsigned above: want 7, got %d", got)
+	}
+}
+
+func TestWrapWideRange(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value int8
+		want  int8
+	}{
+		{name: "within", value: 50, want: 50},
+		{name: "below min", value: -128, want: 72},
+		{name: "above max", value: 127, want: -73},
+		{name: "max", value: 100, want: -100},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Wrap[int8](tc.value, -100, 100)
+			if got != tc.want {
+				t.Errorf("want %d, got %d", tc.want, got)
+			}
+		})
+	}
+	if got := Wrap[uint8](255, 10, 250); got != 15 {
+		t.Errorf("uint8: want 15, got %d", got)
+	}
+	if got := Wrap[int64](math.MinInt64, -1, math.MaxInt64); got != 0 {
+		t.Errorf("int64: want 0, got %d", got)
+	}
+}
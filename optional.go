@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package typ
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type optionalState uint8
+
+const (
+	optionalUndefined optionalState = iota
+	optionalNull
+	optionalPresent
+)
+
+// Optional is a tri-state value that can be undefined, null, or hold a value.
+// This is useful for inputs such as JSON PATCH requests or GraphQL arguments,
+// where an omitted field has a different meaning than an explicit null.
+//
+// The zero value is undefined. When unmarshalled from JSON, a field that is
+// not present in the input stays undefined, a JSON null results in a null
+// Optional, and any other JSON value results in a present Optional.
+//
+// Undefined fields can be omitted when marshalling by using the "omitzero"
+// struct tag option, which is supported by encoding/json since Go 1.24:
+//
+//	type Patch struct {
+//		Name typ.Optional[string] `json:"name,omitzero"`
+//	}
+//
+// On older Go versions, or without "omitzero", the undefined state cannot be
+// preserved through marshalling, as an undefined Optional is marshalled as a
+// JSON null and is therefore unmarshalled back as a null Optional.
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+// Undefined returns an undefined Optional.
+func Undefined[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// NullValue returns an Optional that is explicitly null.
+func NullValue[T any]() Optional[T] {
+	return Optional[T]{state: optionalNull}
+}
+
+// Of returns an Optional that holds the given value.
+func Of[T any](value T) Optional[T] {
+	return Optional[T]{value: value, state: optionalPresent}
+}
+
+// IsUndefined returns true if this Optional has not been set.
+func (o Optional[T]) IsUndefined() bool {
+	return o.state == optionalUndefined
+}
+
+// IsNull returns true if this Optional has explicitly been set to null.
+func (o Optional[T]) IsNull() bool {
+	return o.state == optionalNull
+}
+
+// IsPresent returns true if this Optional holds a value.
+func (o Optional[T]) IsPresent() bool {
+	return o.state == optionalPresent
+}
+
+// IsZero returns true if this Optional is undefined. This is used by
+// encoding/json to omit undefined fields tagged with "omitzero".
+func (o Optional[T]) IsZero() bool {
+	return o.IsUndefined()
+}
+
+// Get returns the value and true if this Optional holds a value, or the zero
+// value and false if it is undefined or null.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.IsPresent()
+}
+
+// MarshalJSON implements json.Marshaler. Undefined and null values are both
+// marshalled as a JSON null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.IsPresent() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null results in a null
+// Optional, while any other value results in a present Optional.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*o = NullValue[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Of(value)
+	return nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+//go:build go1.24
+
+package typ
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type optionalPatchOmitZero struct {
+	Name Optional[string] `json:"name,omitzero"`
+	Age  Optional[int]    `json:"age,omitzero"`
+}
+
+func TestOptionalRoundTripJSONOmitZero(t *testing.T) {
+	testCases := []struct {
+		name     string
+		patch    optionalPatchOmitZero
+		wantJSON string
+	}{
+		{
+			name:     "undefined",
+			patch:    optionalPatchOmitZero{Age: Of(1)},
+			wantJSON: `{"age":1}`,
+		},
+		{
+			name:     "null",
+			patch:    optionalPatchOmitZero{Name: NullValue[string](), Age: Of(1)},
+			wantJSON: `{"name":null,"age":1}`,
+		},
+		{
+			name:     "present",
+			patch:    optionalPatchOmitZero{Name: Of("john"), Age: Of(1)},
+			wantJSON: `{"name":"john","age":1}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tc.wantJSON {
+				t.Errorf("want %s, got %s", tc.wantJSON, data)
+			}
+			var got optionalPatchOmitZero
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.patch {
+				t.Errorf("round-trip via %s: want %+v, got %+v", data, tc.patch, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package typ
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type optionalPatch struct {
+	Name Optional[string] `json:"name"`
+	Age  Optional[int]    `json:"age"`
+}
+
+func TestOptionalStates(t *testing.T) {
+	var zero Optional[int]
+	assertIsTrue(t, "zero IsUndefined", zero.IsUndefined())
+	assertIsTrue(t, "Undefined IsUndefined", Undefined[int]().IsUndefined())
+	assertIsTrue(t, "NullValue IsNull", NullValue[int]().IsNull())
+	assertIsTrue(t, "Of IsPresent", Of(5).IsPresent())
+	assertIsFalse(t, "Of IsNull", Of(5).IsNull())
+	assertIsFalse(t, "NullValue IsPresent", NullValue[int]().IsPresent())
+
+	if v, ok := Of(5).Get(); !ok || v != 5 {
+		t.Errorf("Of(5).Get(): want (5, true), got (%d, %t)", v, ok)
+	}
+	if _, ok := NullValue[int]().Get(); ok {
+		t.Error("NullValue().Get(): want ok=false, got true")
+	}
+}
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		wantUndefined bool
+		wantNull      bool
+		wantName      string
+	}{
+		{name: "undefined", input: `{"age":1}`, wantUndefined: true},
+		{name: "null", input: `{"name":null,"age":1}`, wantNull: true},
+		{name: "present", input: `{"name":"john","age":1}`, wantName: "john"},
+		{name: "present empty", input: `{"name":"","age":1}`, wantName: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var patch optionalPatch
+			if err := json.Unmarshal([]byte(tc.input), &patch); err != nil {
+				t.Fatal(err)
+			}
+			if patch.Name.IsUndefined() != tc.wantUndefined {
+				t.Errorf("IsUndefined: want %t, got %t", tc.wantUndefined, patch.Name.IsUndefined())
+			}
+			if patch.Name.IsNull() != tc.wantNull {
+				t.Errorf("IsNull: want %t, got %t", tc.wantNull, patch.Name.IsNull())
+			}
+			wantPresent := !tc.wantUndefined && !tc.wantNull
+			if name, ok := patch.Name.Get(); ok != wantPresent || name != tc.wantName {
+				t.Errorf("Get: want (%q, %t), got (%q, %t)", tc.wantName, wantPresent, name, ok)
+			}
+			if age, ok := patch.Age.Get(); !ok || age != 1 {
+				t.Errorf("Age: want (1, true), got (%d, %t)", age, ok)
+			}
+		})
+	}
+}
+
+func TestOptionalRoundTripJSON(t *testing.T) {
+	for _, patch := range []optionalPatch{
+		{Name: NullValue[string](), Age: Of(1)},
+		{Name: Of("john"), Age: Of(1)},
+	} {
+		data, err := json.Marshal(patch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got optionalPatch
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != patch {
+			t.Errorf("round-trip via %s: want %+v, got %+v", data, patch, got)
+		}
+	}
+}
+
+func TestOptionalRoundTripJSONUndefinedBecomesNull(t *testing.T) {
+	data, err := json.Marshal(optionalPatch{Age: Of(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":null,"age":1}`; string(data) != want {
+		t.Errorf("want %s, got %s", want, data)
+	}
+
+	// Without "omitzero", the undefined state is lost through marshalling.
+	var got optionalPatch
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Name.IsNull() {
+		t.Errorf("want undefined to round-trip as null, got %+v", got.Name)
+	}
+}
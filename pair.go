@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package typ
+
+// Pair holds two values of possibly different types.
+type Pair[TA, TB any] struct {
+	A TA
+	B TB
+}
+
+// NewPair returns a new pair of the two values.
+func NewPair[TA, TB any](a TA, b TB) Pair[TA, TB] {
+	return Pair[TA, TB]{a, b}
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package slices
+
+// Cycle returns an iterator that yields all elements of the slice, over and
+// over again indefinitely. It's up to the caller to stop the iteration.
+// If the slice is empty, then nothing is yielded.
+//
+// The returned function is compatible with Go 1.23's iter.Seq and
+// range-over-func loops:
+//
+//	for v := range slices.Cycle(slice) {
+//		// ...
+//	}
+func Cycle[S ~[]E, E any](slice S) func(yield func(value E) bool) {
+	return func(yield func(value E) bool) {
+		if len(slice) == 0 {
+			return
+		}
+		for {
+			for _, v := range slice {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerate returns an iterator that yields all elements of the slice together
+// with their index. This gives the same result as a regular range loop over
+// the slice, but can be composed with other iterator functions.
+//
+// The returned function is compatible with Go 1.23's iter.Seq2 and
+// range-over-func loops:
+//
+//	for i, v := range slices.Enumerate(slice) {
+//		// ...
+//	}
+func Enumerate[S ~[]E, E any](slice S) func(yield func(index int, value E) bool) {
+	return func(yield func(index int, value E) bool) {
+		for i, v := range slice {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// WindowedIter returns an iterator that yields all windows, where each window
+// is a slice of the specified size from the specified slice. This gives the
+// same windows as Windowed, but without allocating a slice of all windows.
+//
+// The returned function is compatible with Go 1.23's iter.Seq and
+// range-over-func loops:
+//
+//	for window := range slices.WindowedIter(slice, 3) {
+//		// ...
+//	}
+func WindowedIter[S ~[]E, E any](slice S, size int) func(yield func(window S) bool) {
+	return func(yield func(window S) bool) {
+		for i := 0; i+size <= len(slice); i++ {
+			if !yield(slice[i : i+size]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package slices
+
+import "testing"
+
+func TestCycle(t *testing.T) {
+	var got []int
+	Cycle([]int{1, 2, 3})(func(value int) bool {
+		got = append(got, value)
+		return len(got) < 8
+	})
+	assertSlice(t, "Cycle", []int{1, 2, 3, 1, 2, 3, 1, 2}, got)
+}
+
+func TestCycleEmpty(t *testing.T) {
+	var calls int
+	Cycle([]int{})(func(int) bool {
+		calls++
+		return true
+	})
+	if calls != 0 {
+		t.Errorf("want 0 calls, got %d", calls)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	var gotIndices []int
+	var gotValues []string
+	Enumerate([]string{"a", "b", "c"})(func(index int, value string) bool {
+		gotIndices = append(gotIndices, index)
+		gotValues = append(gotValues, value)
+		return true
+	})
+	assertSlice(t, "indices", []int{0, 1, 2}, gotIndices)
+	assertSlice(t, "values", []string{"a", "b", "c"}, gotValues)
+}
+
+func TestEnumerateBreak(t *testing.T) {
+	var gotValues []string
+	Enumerate([]string{"a", "b", "c"})(func(index int, value string) bool {
+		gotValues = append(gotValues, value)
+		return index < 1
+	})
+	assertSlice(t, "values", []string{"a", "b"}, gotValues)
+}
+
+func TestWindowedIter(t *testing.T) {
+	in := []byte("abcdefg")
+	var got []string
+	WindowedIter(in, 3)(func(window []byte) bool {
+		got = append(got, string(window))
+		return true
+	})
+	want := Map(Windowed(in, 3), func(window []byte) string {
+		return string(window)
+	})
+	assertSlice(t, "windows", want, got)
+}
+
+func TestWindowedIterBreak(t *testing.T) {
+	in := []byte("abcdefg")
+	var got []string
+	WindowedIter(in, 3)(func(window []byte) bool {
+		got = append(got, string(window))
+		return len(got) < 2
+	})
+	assertSlice(t, "windows", []string{"abc", "bcd"}, got)
+}
+
+func TestWindowedIterTooShort(t *testing.T) {
+	var calls int
+	WindowedIter([]byte("ab"), 3)(func([]byte) bool {
+		calls++
+		return true
+	})
+	if calls != 0 {
+		t.Errorf("want 0 calls, got %d", calls)
+	}
+}
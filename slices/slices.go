@@ -7,6 +7,10 @@
 package slices
 
 import (
+	"fmt"
+	"reflect"
+	"sync"
+
 	"gopkg.in/typ.v4"
 	"gopkg.in/typ.v4/maps"
 	"gopkg.in/typ.v4/sets"
@@ -53,6 +57,30 @@ func RemoveSlice[S ~[]E, E any](slice *S, index int, length int) {
 	*slice = (*slice)[:len(*slice)-length]
 }
 
+// RemoveFunc takes out all values where the predicate returns true, in place,
+// and returns the number of values that were removed. The remaining values
+// keep their original order. The freed elements at the end of the underlying
+// array are set to the zero value, so any values they reference can be garbage
+// collected.
+//
+// This is the in-place equivalent of Filter with the predicate negated.
+func RemoveFunc[S ~[]E, E any](slice *S, pred func(value E) bool) int {
+	s := *slice
+	kept := 0
+	for _, v := range s {
+		if !pred(v) {
+			s[kept] = v
+			kept++
+		}
+	}
+	var zero E
+	for i := kept; i < len(s); i++ {
+		s[i] = zero
+	}
+	*slice = s[:kept]
+	return len(s) - kept
+}
+
 // Index returns the index of a value, or -1 if none found.
 //
 // This differs from Search as Index doesn't require the slice to be sorted.
@@ -78,6 +106,17 @@ func IndexFunc[S ~[]E, E any](slice S, f func(value E) bool) int {
 	return -1
 }
 
+// Find returns the first value where the function returns true, together with
+// its index and true, or the zero value, -1, and false if none found.
+func Find[S ~[]E, E any](slice S, f func(value E) bool) (E, int, bool) {
+	for i, v := range slice {
+		if f(v) {
+			return v, i, true
+		}
+	}
+	return typ.Zero[E](), -1, false
+}
+
 // Repeat creates a new slice with the given value repeated across it.
 func Repeat[E any](value E, count int) []E {
 	result := make([]E, count)
@@ -147,6 +186,13 @@ func Distinct[S ~[]E, E comparable](slice S) S {
 	return result
 }
 
+// DistinctCount returns a new slice of only unique values, together with the
+// number of duplicate values that were removed.
+func DistinctCount[S ~[]E, E comparable](slice S) (S, int) {
+	result := Distinct(slice)
+	return result, len(slice) - len(result)
+}
+
 // DistinctFunc returns a new slice of only unique values.
 func DistinctFunc[S ~[]E, E any](slice S, equals func(a, b E) bool) S {
 	result := make(S, 0, len(slice))
@@ -158,6 +204,23 @@ func DistinctFunc[S ~[]E, E any](slice S, equals func(a, b E) bool) S {
 	return result
 }
 
+// DistinctBy returns a new slice of only unique values, where uniqueness is
+// determined by the key from the function provided. The first occurrence of
+// each key is kept.
+func DistinctBy[S ~[]E, E any, K comparable](slice S, keyer func(value E) K) S {
+	result := make(S, 0, len(slice))
+	seen := make(map[K]struct{}, len(slice))
+	for _, v := range slice {
+		key := keyer(v)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
 // Contains checks if a value exists inside a slice of values.
 func Contains[S ~[]E, E comparable](slice S, value E) bool {
 	for _, v := range slice {
@@ -179,6 +242,59 @@ func ContainsFunc[S ~[]E, E any](slice S, value E, equals func(a, b E) bool) boo
 	return false
 }
 
+// Equal returns true if both slices have the same length and all values are
+// equal pairwise. A nil slice and an empty slice are considered equal.
+func Equal[S ~[]E, E comparable](a, b S) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc returns true if both slices have the same length and all values
+// are equal pairwise, using a custom equals operation. The two slices may have
+// different element types. A nil slice and an empty slice are considered
+// equal.
+func EqualFunc[S1 ~[]E1, S2 ~[]E2, E1, E2 any](a S1, b S2, equals func(a E1, b E2) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equals(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of occurrences of a value inside a slice of values.
+func Count[S ~[]E, E comparable](slice S, value E) int {
+	var count int
+	for _, v := range slice {
+		if v == value {
+			count++
+		}
+	}
+	return count
+}
+
+// CountFunc returns the number of values inside a slice of values that
+// matches the predicate.
+func CountFunc[S ~[]E, E any](slice S, pred func(value E) bool) int {
+	var count int
+	for _, v := range slice {
+		if pred(v) {
+			count++
+		}
+	}
+	return count
+}
+
 // TryGet will get a value from a slice, or return false on the second return
 // value if the index is outside the bounds of the slice. Passing a nil slice is
 // equivalent to passing an empty slice.
@@ -220,6 +336,18 @@ func Any[S ~[]E, E any](slice S, cond func(value E) bool) bool {
 	return false
 }
 
+// AnyFunc checks if any value matches the condition. Returns false if the
+// slice is empty. This is an alias for Any.
+func AnyFunc[S ~[]E, E any](slice S, cond func(value E) bool) bool {
+	return Any(slice, cond)
+}
+
+// NoneFunc checks if no value matches the condition. Returns true if the slice
+// is empty. This is the negation of Any.
+func NoneFunc[S ~[]E, E any](slice S, cond func(value E) bool) bool {
+	return !Any(slice, cond)
+}
+
 // All checks if all values matches the condition. Returns true if the slice is
 // empty.
 func All[S ~[]E, E any](slice S, cond func(value E) bool) bool {
@@ -241,6 +369,17 @@ func Map[S ~[]E, E, Result any](slice S, conv func(value E) Result) []Result {
 	return result
 }
 
+// MapIndex will apply a conversion function to all elements in a slice and
+// return the new slice with converted values. The conversion function is also
+// given the index of each element.
+func MapIndex[S ~[]E, E, Result any](slice S, conv func(index int, value E) Result) []Result {
+	result := make([]Result, len(slice))
+	for i, v := range slice {
+		result[i] = conv(i, v)
+	}
+	return result
+}
+
 // MapErr will apply a conversion function to all elements in a slice and return
 // the new slice with converted values. Will cancel the conversion on the first
 // error occurrence.
@@ -267,6 +406,68 @@ func Filter[S ~[]E, E any](slice S, match func(value E) bool) S {
 	return result
 }
 
+// FilterIndex will return a new slice of all matching elements. The match
+// function is also given the index of each element.
+func FilterIndex[S ~[]E, E any](slice S, match func(index int, value E) bool) S {
+	result := make(S, 0, len(slice))
+	for i, v := range slice {
+		if match(i, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// MapErrAll will apply a conversion function to all elements in a slice and
+// return a new slice with only the successfully converted values, together
+// with all errors that occurred. Each error is wrapped with the index of the
+// element that failed to be converted.
+func MapErrAll[S ~[]E, E, Result any](slice S, conv func(value E) (Result, error)) ([]Result, []error) {
+	result := make([]Result, 0, len(slice))
+	var errs []error
+	for i, v := range slice {
+		r, err := conv(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+			continue
+		}
+		result = append(result, r)
+	}
+	return result, errs
+}
+
+// Partition will return two new slices, where the first contains all matching
+// elements and the second contains the rest. The order of the elements is
+// preserved in both slices. Neither of the returned slices are nil.
+func Partition[S ~[]E, E any](slice S, match func(value E) bool) (matching S, rest S) {
+	matching = make(S, 0)
+	rest = make(S, 0)
+	for _, v := range slice {
+		if match(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matching, rest
+}
+
+// FilterMap will apply a conversion function to all elements in a slice and
+// return a new slice of only the converted values where the conversion
+// function also returned true.
+//
+// This is equivalent to calling Filter followed by Map, but only iterates the
+// slice once.
+func FilterMap[S ~[]E, E, Result any](slice S, conv func(value E) (Result, bool)) []Result {
+	result := make([]Result, 0, len(slice))
+	for _, v := range slice {
+		if r, ok := conv(v); ok {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
 // Fold will accumulate an answer based on all values in a slice. Returns the
 // seed value as-is if the slice is empty.
 func Fold[S ~[]E, State, E any](slice S, seed State, acc func(state State, value E) State) State {
@@ -277,6 +478,52 @@ func Fold[S ~[]E, State, E any](slice S, seed State, acc func(state State, value
 	return state
 }
 
+// Reduce will accumulate an answer based on all values in a slice, and stops
+// on the first error returned by the accumulator. The state accumulated so far
+// is returned together with the error. Returns the seed value as-is if the
+// slice is empty.
+func Reduce[S ~[]E, State, E any](slice S, seed State, acc func(state State, value E) (State, error)) (State, error) {
+	state := seed
+	for _, v := range slice {
+		next, err := acc(state, v)
+		if err != nil {
+			return state, err
+		}
+		state = next
+	}
+	return state, nil
+}
+
+// ReduceWhile will accumulate an answer based on the values in a slice, and
+// stops as soon as the accumulator returns false. The state returned by that
+// last call to the accumulator is returned, and the remaining values are not
+// processed. Returns the seed value as-is if the slice is empty.
+func ReduceWhile[S ~[]E, State, E any](slice S, seed State, acc func(state State, value E) (State, bool)) State {
+	state := seed
+	for _, v := range slice {
+		var ok bool
+		state, ok = acc(state, v)
+		if !ok {
+			break
+		}
+	}
+	return state
+}
+
+// Scan will accumulate an answer based on all values in a slice, similar to
+// Fold, but returns a new slice with every intermediate state. The seed value
+// is not included in the result, so the returned slice has the same length as
+// the given slice.
+func Scan[S ~[]E, State, E any](slice S, seed State, acc func(state State, value E) State) []State {
+	result := make([]State, len(slice))
+	state := seed
+	for i, v := range slice {
+		state = acc(state, v)
+		result[i] = state
+	}
+	return result
+}
+
 // FoldReverse will accumulate an answer based on all values in a slice,
 // starting with the last element and accumulating backwards. Returns the
 // seed value as-is if the slice is empty.
@@ -296,6 +543,42 @@ func Concat[S ~[]E, E any](a, b S) S {
 	return result
 }
 
+// Join returns a new slice with the separator value inserted between each
+// pair of adjacent values in the slice. Slices with fewer than two values are
+// returned as a copy, without any separator.
+func Join[S ~[]E, E any](slice S, sep E) S {
+	if len(slice) < 2 {
+		return Clone(slice)
+	}
+	result := make(S, 2*len(slice)-1)
+	result[0] = slice[0]
+	for i, v := range slice[1:] {
+		result[2*i+1] = sep
+		result[2*i+2] = v
+	}
+	return result
+}
+
+// Flatten returns a new slice with the values from all the slices
+// concatenated. Returns an empty slice if there are no values.
+func Flatten[S ~[]E, E any](slices []S) S {
+	var length int
+	for _, s := range slices {
+		length += len(s)
+	}
+	result := make(S, 0, length)
+	for _, s := range slices {
+		result = append(result, s...)
+	}
+	return result
+}
+
+// FlatMap will apply a conversion function to all elements in a slice and
+// return a new slice with all the converted values concatenated.
+func FlatMap[S ~[]E, E, Result any](slice S, conv func(value E) []Result) []Result {
+	return Flatten(Map(slice, conv))
+}
+
 // Grouping is a key-values store returned by the GroupBy functions.
 type Grouping[K, V any] struct {
 	Key    K
@@ -325,6 +608,27 @@ func GroupBy[S ~[]V, K comparable, V any](slice S, keyer func(value V) K) []Grou
 	return groups
 }
 
+// GroupConsecutive will group all consecutive elements in the slice that
+// share the same key, using the key from the function provided. Unlike
+// GroupBy, a new group is started whenever the key changes, so the same key
+// may appear in multiple groups if its elements are not adjacent.
+func GroupConsecutive[S ~[]V, K comparable, V any](slice S, keyer func(value V) K) []Grouping[K, V] {
+	var groups []Grouping[K, V]
+	for _, v := range slice {
+		key := keyer(v)
+		if len(groups) > 0 && groups[len(groups)-1].Key == key {
+			last := &groups[len(groups)-1]
+			last.Values = append(last.Values, v)
+			continue
+		}
+		groups = append(groups, Grouping[K, V]{
+			Key:    key,
+			Values: []V{v},
+		})
+	}
+	return groups
+}
+
 // Counting is a key-count store returned by the CountBy function.
 type Counting[K any] struct {
 	Key   K
@@ -354,6 +658,69 @@ func CountBy[S ~[]V, K comparable, V any](slice S, keyer func(value V) K) []Coun
 	return groups
 }
 
+// Frequencies returns a map of how many times each distinct value occurs in
+// the slice. This is similar to CountBy, but keyed by the values themselves
+// and returned as a map instead.
+func Frequencies[S ~[]E, E comparable](slice S) map[E]int {
+	m := make(map[E]int)
+	for _, v := range slice {
+		m[v]++
+	}
+	return m
+}
+
+// Associate returns a map of key-value pairs produced by the function provided
+// for each element in the slice. If multiple elements produce the same key,
+// then the value from the last such element is kept.
+func Associate[S ~[]E, E any, K comparable, V any](slice S, transform func(value E) (K, V)) map[K]V {
+	m := make(map[K]V, len(slice))
+	for _, v := range slice {
+		key, value := transform(v)
+		m[key] = value
+	}
+	return m
+}
+
+// AssociateBy returns a map of all elements in the slice, keyed by the key
+// from the function provided. If multiple elements produce the same key, then
+// the last such element is kept.
+func AssociateBy[S ~[]E, E any, K comparable](slice S, keyer func(value E) K) map[K]E {
+	m := make(map[K]E, len(slice))
+	for _, v := range slice {
+		m[keyer(v)] = v
+	}
+	return m
+}
+
+// AssociateWith returns a map where all elements in the slice are keys, with
+// the value from the function provided.
+func AssociateWith[S ~[]K, K comparable, V any](slice S, valuer func(key K) V) map[K]V {
+	m := make(map[K]V, len(slice))
+	for _, k := range slice {
+		m[k] = valuer(k)
+	}
+	return m
+}
+
+// ToMap returns a map of all elements in the slice, using the key and value
+// from the functions provided. Keys are expected to be unique, but if multiple
+// elements produce the same key, then the value from the last such element is
+// kept.
+func ToMap[S ~[]E, E any, K comparable, V any](slice S, keyer func(value E) K, valuer func(value E) V) map[K]V {
+	m := make(map[K]V, len(slice))
+	for _, v := range slice {
+		m[keyer(v)] = valuer(v)
+	}
+	return m
+}
+
+// ToMapKeyed returns a map of all elements in the slice, keyed by the key from
+// the function provided. If multiple elements produce the same key, then the
+// last such element is kept. This is an alias for AssociateBy.
+func ToMapKeyed[S ~[]E, E any, K comparable](slice S, keyer func(value E) K) map[K]E {
+	return AssociateBy(slice, keyer)
+}
+
 // Pairs returns a slice of pairs for the given slice. If the slice has less
 // than two items, then an empty slice is returned.
 func Pairs[S ~[]E, E any](slice S) [][2]E {
@@ -380,6 +747,22 @@ func PairsFunc[S ~[]E, E any](slice S, callback func(a, b E)) {
 	}
 }
 
+// PairsErr invokes the provided callback for all pairs for the given slice,
+// and stops on the first error returned by the callback.
+// If the slice has less than two items, then no invokation is performed.
+func PairsErr[S ~[]E, E any](slice S, callback func(a, b E) error) error {
+	if len(slice) < 2 {
+		return nil
+	}
+	lim := len(slice) - 1
+	for i := 0; i < lim; i++ {
+		if err := callback(slice[i], slice[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Windowed returns a slice of windows, where each window is a slice of the
 // specified size from the specified slice.
 func Windowed[S ~[]E, E any](slice S, size int) []S {
@@ -406,6 +789,22 @@ func WindowedFunc[S ~[]E, E any](slice S, size int, callback func(window S)) {
 	}
 }
 
+// WindowedErr invokes the provided callback for all windows, where each window
+// is a slice of the specified size from the specified slice, and stops on the
+// first error returned by the callback.
+func WindowedErr[S ~[]E, E any](slice S, size int, callback func(window S) error) error {
+	if len(slice) < size {
+		return nil
+	}
+	lim := len(slice) - size + 1
+	for i := 0; i < lim; i++ {
+		if err := callback(slice[i : i+size]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Chunk divides the slice up into chunks with a size limit. The last chunk
 // may be smaller than size if the slice is not evenly divisible.
 func Chunk[S ~[]E, E any](slice S, size int) []S {
@@ -442,6 +841,129 @@ func ChunkFunc[S ~[]E, E any](slice S, size int, callback func(chunk S)) {
 	}
 }
 
+// ChunkedMapParallel divides the slice up into chunks, and applies the
+// conversion function on each chunk concurrently using the given number of
+// worker goroutines. The results from all chunks are concatenated in the same
+// order as the chunks. The last chunk may be smaller than chunkSize if the
+// slice is not evenly divisible. Will panic if chunkSize is zero or negative.
+//
+// If workers is less than 1, then a single worker is used.
+func ChunkedMapParallel[S ~[]E, E, Result any](slice S, chunkSize, workers int, conv func(chunk S) []Result) []Result {
+	if chunkSize <= 0 {
+		panic(fmt.Sprintf("slices.ChunkedMapParallel: chunk size must be positive, got %d", chunkSize))
+	}
+	chunkCount := (len(slice) + chunkSize - 1) / chunkSize
+	results := make([][]Result, chunkCount)
+	workers = typ.Clamp(workers, 1, chunkCount)
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				start := i * chunkSize
+				end := typ.Min(start+chunkSize, len(slice))
+				results[i] = conv(slice[start:end])
+			}
+		}()
+	}
+	for i := range results {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return Flatten(results)
+}
+
+// ChunkErr divides the slice up into chunks and invokes the callback on each
+// chunk, and stops on the first error returned by the callback. The last chunk
+// may be smaller than size if the slice is not evenly divisible.
+func ChunkErr[S ~[]E, E any](slice S, size int, callback func(chunk S) error) error {
+	if len(slice) == 0 {
+		return nil
+	}
+	div := len(slice) / size
+	rounded := div * size
+	for j := 0; j < rounded; j += size {
+		if err := callback(slice[j : j+size]); err != nil {
+			return err
+		}
+	}
+	if rounded != len(slice) {
+		return callback(slice[rounded:])
+	}
+	return nil
+}
+
+// ChunkByCount divides the slice up into the given number of chunks, with
+// sizes as equal as possible. If the slice is not evenly divisible, then the
+// earlier chunks will get one extra element each. If the count is larger than
+// the slice length, then fewer chunks are returned, as no chunk is empty.
+// Will panic if count is zero or negative.
+func ChunkByCount[S ~[]E, E any](slice S, count int) []S {
+	if count <= 0 {
+		panic(fmt.Sprintf("slices.ChunkByCount: count must be positive, got %d", count))
+	}
+	if len(slice) == 0 {
+		return nil
+	}
+	if count > len(slice) {
+		count = len(slice)
+	}
+	size := len(slice) / count
+	remainder := len(slice) % count
+	chunks := make([]S, count)
+	start := 0
+	for i := range chunks {
+		end := start + size
+		if i < remainder {
+			end++
+		}
+		chunks[i] = slice[start:end]
+		start = end
+	}
+	return chunks
+}
+
+// Zip returns a slice of pairs, where each pair holds the values from the
+// two slices at the same index. If the slices differ in length, then the
+// result is truncated to the length of the shorter slice, and the remaining
+// values in the longer slice are ignored.
+//
+// The returned slice is never nil, even when either input slice is empty.
+func Zip[A, B any](a []A, b []B) []typ.Pair[A, B] {
+	pairs := make([]typ.Pair[A, B], typ.Min(len(a), len(b)))
+	for i := range pairs {
+		pairs[i] = typ.Pair[A, B]{A: a[i], B: b[i]}
+	}
+	return pairs
+}
+
+// Unzip returns two slices with the values from each pair, and is the
+// inverse of Zip.
+func Unzip[A, B any](pairs []typ.Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+	for i, p := range pairs {
+		a[i] = p.A
+		b[i] = p.B
+	}
+	return a, b
+}
+
+// ToSet returns a new set with all the unique values from the slice. The
+// returned set is a maps.Set.
+func ToSet[S ~[]E, E comparable](slice S) sets.Set[E] {
+	return maps.NewSetFromSlice(slice)
+}
+
+// FromSet returns a new slice with all the values from the set, in no
+// particular order. This is equivalent to calling set.Slice().
+func FromSet[E comparable](set sets.Set[E]) []E {
+	return set.Slice()
+}
+
 // Except returns a new slice for all items that are not found in the slice of
 // items to exclude.
 func Except[S ~[]E, E comparable](slice S, exclude S) S {
@@ -461,12 +983,281 @@ func ExceptSet[S ~[]E, E comparable](slice S, exclude sets.Set[E]) S {
 	return result
 }
 
+// Intersect returns a new slice with all unique items that are found in both
+// slices, in the order they first appear in slice a.
+func Intersect[S ~[]E, E comparable](a, b S) S {
+	inB := maps.NewSetFromSlice(b)
+	seen := make(maps.Set[E], len(a))
+	result := make(S, 0, typ.Min(len(a), len(b)))
+	for _, v := range a {
+		if inB.Has(v) && seen.Add(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Union returns a new slice with all unique items that are found in either
+// slice, in the order they first appear in slice a, followed by the order they
+// first appear in slice b.
+func Union[S ~[]E, E comparable](a, b S) S {
+	seen := make(maps.Set[E], len(a)+len(b))
+	result := make(S, 0, len(a)+len(b))
+	for _, slice := range []S{a, b} {
+		for _, v := range slice {
+			if seen.Add(v) {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
 // Last returns the last item in a slice. Will panic with an out of bound error
 // if the slice is empty.
 func Last[S ~[]E, E any](slice S) E {
 	return slice[len(slice)-1]
 }
 
+// MinFloat returns the smallest value in a slice of floats. Any NaN values are
+// ignored, unless all values are NaN in which case NaN is returned. Will panic
+// if the slice is empty.
+func MinFloat[S ~[]E, E typ.Float](slice S) E {
+	if len(slice) == 0 {
+		panic("slices.MinFloat: slice must not be empty")
+	}
+	min := slice[0]
+	for _, v := range slice[1:] {
+		if isNaN(v) {
+			continue
+		}
+		if isNaN(min) || v < min {
+			min = v
+		}
+	}
+	return min
+}
+
+// MaxFloat returns the largest value in a slice of floats. Any NaN values are
+// ignored, unless all values are NaN in which case NaN is returned. Will panic
+// if the slice is empty.
+func MaxFloat[S ~[]E, E typ.Float](slice S) E {
+	if len(slice) == 0 {
+		panic("slices.MaxFloat: slice must not be empty")
+	}
+	max := slice[0]
+	for _, v := range slice[1:] {
+		if isNaN(v) {
+			continue
+		}
+		if isNaN(max) || v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// MinMaxFunc returns the smallest and largest values in a slice, according
+// to the less function, and true if the slice is not empty. If multiple
+// values are equally small or large, then the first one found is returned.
+//
+// Both values are found in a single pass, by comparing the values pairwise,
+// which needs about 1.5n comparisons instead of the 2n needed when searching
+// for the smallest and largest values separately.
+func MinMaxFunc[S ~[]E, E any](slice S, less func(a, b E) bool) (min, max E, ok bool) {
+	if len(slice) == 0 {
+		return min, max, false
+	}
+	min, max = slice[0], slice[0]
+	i := 1
+	for ; i+1 < len(slice); i += 2 {
+		lo, hi := slice[i], slice[i+1]
+		if less(hi, lo) {
+			lo, hi = hi, lo
+		} else if !less(lo, hi) {
+			hi = lo
+		}
+		if less(lo, min) {
+			min = lo
+		}
+		if less(max, hi) {
+			max = hi
+		}
+	}
+	if i < len(slice) {
+		if v := slice[i]; less(v, min) {
+			min = v
+		} else if less(max, v) {
+			max = v
+		}
+	}
+	return min, max, true
+}
+
+// MinBy returns the value in the slice with the smallest key, as returned by
+// the key function, and true if the slice is not empty. If multiple values
+// have equally small keys, then the first one found is returned. The key
+// function is called exactly once per value.
+func MinBy[S ~[]E, E any, K typ.Ordered](slice S, key func(value E) K) (E, bool) {
+	if len(slice) == 0 {
+		var zero E
+		return zero, false
+	}
+	min, minKey := slice[0], key(slice[0])
+	for _, v := range slice[1:] {
+		if k := key(v); k < minKey {
+			min, minKey = v, k
+		}
+	}
+	return min, true
+}
+
+// MaxBy returns the value in the slice with the largest key, as returned by
+// the key function, and true if the slice is not empty. If multiple values
+// have equally large keys, then the first one found is returned. The key
+// function is called exactly once per value.
+func MaxBy[S ~[]E, E any, K typ.Ordered](slice S, key func(value E) K) (E, bool) {
+	if len(slice) == 0 {
+		var zero E
+		return zero, false
+	}
+	max, maxKey := slice[0], key(slice[0])
+	for _, v := range slice[1:] {
+		if k := key(v); k > maxKey {
+			max, maxKey = v, k
+		}
+	}
+	return max, true
+}
+
+// SumBy returns the sum of the numbers returned by the key function for each
+// value in the slice. Returns zero if the slice is empty.
+func SumBy[S ~[]E, E any, N typ.Number](slice S, key func(value E) N) N {
+	var sum N
+	for _, v := range slice {
+		sum += key(v)
+	}
+	return sum
+}
+
+// AverageBy returns the average of the numbers returned by the key function
+// for each value in the slice, and true if the slice is not empty. For integer
+// types, the result is truncated towards zero, just like integer division.
+//
+// The number type must be able to represent the length of the slice, as the
+// sum is divided by the length converted to that type. Will panic if the
+// length does not fit, such as a slice of 256 values averaged as uint8.
+func AverageBy[S ~[]E, E any, N typ.Number](slice S, key func(value E) N) (N, bool) {
+	var sum N
+	if len(slice) == 0 {
+		return sum, false
+	}
+	for _, v := range slice {
+		sum += key(v)
+	}
+	count, ok := numberFromLen[N](len(slice))
+	if !ok {
+		panic(fmt.Sprintf("slices.AverageBy: slice length %d overflows number type %T", len(slice), count))
+	}
+	return sum / count, true
+}
+
+// numberFromLen converts a length to any number type, or returns false if the
+// length overflows the number type. Reflection is used as Go does not allow
+// converting an int to a type parameter that may be a complex number.
+func numberFromLen[N typ.Number](n int) (N, bool) {
+	var result N
+	v := reflect.ValueOf(&result).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.OverflowInt(int64(n)) {
+			return result, false
+		}
+		v.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.OverflowUint(uint64(n)) {
+			return result, false
+		}
+		v.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(n))
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(float64(n), 0))
+	}
+	return result, true
+}
+
+func isNaN[T typ.Float](v T) bool {
+	return v != v
+}
+
+// FirstNonEmpty returns the first slice that has a length greater than zero,
+// or nil if all slices are empty. This is the slice equivalent of typ.Coal,
+// which cannot be used on slices as they are not comparable.
+func FirstNonEmpty[S ~[]E, E any](slices ...S) S {
+	for _, s := range slices {
+		if len(s) > 0 {
+			return s
+		}
+	}
+	return nil
+}
+
+// CoalesceFunc returns the first value in the slice for which the isZero
+// function returns false, and true if such a value was found. Otherwise the
+// zero value and false is returned. This is a variant of typ.Coal that can be
+// used on element types that are not comparable.
+func CoalesceFunc[S ~[]E, E any](values S, isZero func(value E) bool) (E, bool) {
+	for _, v := range values {
+		if !isZero(v) {
+			return v, true
+		}
+	}
+	var zero E
+	return zero, false
+}
+
+// FirstN returns a slice of the first n items in a slice, or the whole slice
+// if it has fewer than n items. The returned slice shares the same underlying
+// array as the original slice.
+func FirstN[S ~[]E, E any](slice S, n int) S {
+	return slice[:typ.Clamp(n, 0, len(slice))]
+}
+
+// LastN returns a slice of the last n items in a slice, or the whole slice
+// if it has fewer than n items. The returned slice shares the same underlying
+// array as the original slice.
+func LastN[S ~[]E, E any](slice S, n int) S {
+	return slice[len(slice)-typ.Clamp(n, 0, len(slice)):]
+}
+
+// PadLeft returns a new slice with the pad value added to the start of the
+// slice until it reaches the given length. The slice is returned as-is if it
+// is already at least that long.
+func PadLeft[S ~[]E, E any](slice S, length int, pad E) S {
+	if len(slice) >= length {
+		return slice
+	}
+	result := make(S, length)
+	offset := length - len(slice)
+	Fill(result[:offset], pad)
+	copy(result[offset:], slice)
+	return result
+}
+
+// PadRight returns a new slice with the pad value added to the end of the
+// slice until it reaches the given length. The slice is returned as-is if it
+// is already at least that long.
+func PadRight[S ~[]E, E any](slice S, length int, pad E) S {
+	if len(slice) >= length {
+		return slice
+	}
+	result := make(S, length)
+	copy(result, slice)
+	Fill(result[len(slice):], pad)
+	return result
+}
+
 // Clone returns a shallow copy of a slice.
 func Clone[S ~[]E, E any](slice S) S {
 	newSlice := make(S, len(slice))
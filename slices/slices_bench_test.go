@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package slices
+
+import (
+	"runtime"
+	"testing"
+)
+
+func benchChunkConv(chunk []int) []int {
+	result := make([]int, len(chunk))
+	for i, v := range chunk {
+		for j := 0; j < 100; j++ {
+			v = v*31 + j
+		}
+		result[i] = v
+	}
+	return result
+}
+
+func benchChunkSlice() []int {
+	slice := make([]int, 100000)
+	for i := range slice {
+		slice[i] = i
+	}
+	return slice
+}
+
+func BenchmarkChunkedMapSequential(b *testing.B) {
+	slice := benchChunkSlice()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := make([]int, 0, len(slice))
+		ChunkFunc(slice, 1000, func(chunk []int) {
+			result = append(result, benchChunkConv(chunk)...)
+		})
+	}
+}
+
+func BenchmarkChunkedMapParallel(b *testing.B) {
+	slice := benchChunkSlice()
+	workers := runtime.GOMAXPROCS(0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ChunkedMapParallel(slice, 1000, workers, benchChunkConv)
+	}
+}
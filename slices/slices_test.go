@@ -5,7 +5,10 @@
 package slices
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"testing"
 
 	"gopkg.in/typ.v4/internal/assert"
@@ -188,6 +191,363 @@ func TestRemoveSlice(t *testing.T) {
 	}
 }
 
+func TestRemoveFunc(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     string
+		want      string
+		wantCount int
+	}{
+		{name: "start", slice: "__start", want: "start", wantCount: 2},
+		{name: "end", slice: "end__", want: "end", wantCount: 2},
+		{name: "middle", slice: "mi_dd_le", want: "middle", wantCount: 2},
+		{name: "all", slice: "___", want: "", wantCount: 3},
+		{name: "none", slice: "none", want: "none", wantCount: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slice := []byte(tc.slice)
+			count := RemoveFunc(&slice, func(value byte) bool {
+				return value == '_'
+			})
+			assert.Comparable(t, "count", tc.wantCount, count)
+			if gotStr := string(slice); gotStr != tc.want {
+				t.Errorf("want %q, got %q", tc.want, gotStr)
+			}
+		})
+	}
+}
+
+func TestRemoveFuncZeroesTail(t *testing.T) {
+	a, b, c := 1, 2, 3
+	slice := []*int{&a, &b, &c}
+	backing := slice
+	RemoveFunc(&slice, func(value *int) bool {
+		return *value != 2
+	})
+	assertSlice(t, "remaining", []*int{&b}, slice)
+	if backing[1] != nil || backing[2] != nil {
+		t.Errorf("want freed tail to be nil, got %v", backing[1:])
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{name: "overlap", a: []int{5, 1, 3, 1, 2}, b: []int{2, 3, 4, 5}, want: []int{5, 3, 2}},
+		{name: "no overlap", a: []int{1, 2}, b: []int{3, 4}, want: []int{}},
+		{name: "nil", a: nil, b: nil, want: []int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Intersect(tc.a, tc.b)
+			if got == nil {
+				t.Error("want non-nil slice, got nil")
+			}
+			assertSlice(t, "Intersect", tc.want, got)
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{name: "overlap", a: []int{3, 1, 3}, b: []int{2, 1, 4, 2}, want: []int{3, 1, 2, 4}},
+		{name: "a empty", a: nil, b: []int{2, 2, 1}, want: []int{2, 1}},
+		{name: "nil", a: nil, b: nil, want: []int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Union(tc.a, tc.b)
+			if got == nil {
+				t.Error("want non-nil slice, got nil")
+			}
+			assertSlice(t, "Union", tc.want, got)
+		})
+	}
+}
+
+func TestToSetFromSet(t *testing.T) {
+	set := ToSet([]string{"a", "b", "a", "c", "b"})
+	assert.Comparable(t, "set len", 3, set.Len())
+	for _, v := range []string{"a", "b", "c"} {
+		if !set.Has(v) {
+			t.Errorf("want set to contain %q", v)
+		}
+	}
+	got := FromSet(set)
+	Sort(got)
+	assertSlice(t, "round-trip", []string{"a", "b", "c"}, got)
+}
+
+func TestAnyFuncNoneFunc(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	testCases := []struct {
+		name     string
+		slice    []int
+		wantAny  bool
+		wantNone bool
+	}{
+		{name: "all match", slice: []int{2, 4, 6}, wantAny: true, wantNone: false},
+		{name: "some match", slice: []int{1, 2, 3}, wantAny: true, wantNone: false},
+		{name: "none match", slice: []int{1, 3, 5}, wantAny: false, wantNone: true},
+		{name: "empty", slice: nil, wantAny: false, wantNone: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Comparable(t, "AnyFunc", tc.wantAny, AnyFunc(tc.slice, isEven))
+			assert.Comparable(t, "NoneFunc", tc.wantNone, NoneFunc(tc.slice, isEven))
+		})
+	}
+}
+
+func TestMapIndex(t *testing.T) {
+	got := MapIndex([]string{"a", "b", "c"}, func(i int, v string) string {
+		return fmt.Sprintf("%d: %s", i, v)
+	})
+	assertSlice(t, "result", []string{"0: a", "1: b", "2: c"}, got)
+}
+
+func TestFilterIndex(t *testing.T) {
+	got := FilterIndex([]string{"a", "b", "c", "d", "e"}, func(i int, _ string) bool {
+		return i%2 == 0
+	})
+	assertSlice(t, "result", []string{"a", "c", "e"}, got)
+}
+
+func TestMapErrAll(t *testing.T) {
+	in := []string{"1", "x", "3", "y", "5"}
+	got, errs := MapErrAll(in, strconv.Atoi)
+	assertSlice(t, "results", []int{1, 3, 5}, got)
+	if len(errs) != 2 {
+		t.Fatalf("want 2 errors, got %d: %v", len(errs), errs)
+	}
+	for i, wantPrefix := range []string{"index 1: ", "index 3: "} {
+		if msg := errs[i].Error(); len(msg) < len(wantPrefix) || msg[:len(wantPrefix)] != wantPrefix {
+			t.Errorf("errs[%d]: want prefix %q, got %q", i, wantPrefix, msg)
+		}
+		if !errors.Is(errs[i], strconv.ErrSyntax) {
+			t.Errorf("errs[%d]: want wrapped strconv.ErrSyntax, got %v", i, errs[i])
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6}
+	even, odd := Partition(in, func(value int) bool {
+		return value%2 == 0
+	})
+	assertSlice(t, "matching", []int{2, 4, 6}, even)
+	assertSlice(t, "rest", []int{1, 3, 5}, odd)
+}
+
+func TestPartitionNonNil(t *testing.T) {
+	matching, rest := Partition([]int(nil), func(int) bool {
+		return true
+	})
+	if matching == nil {
+		t.Error("want non-nil matching slice, got nil")
+	}
+	if rest == nil {
+		t.Error("want non-nil rest slice, got nil")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []int
+		b    []int
+		want bool
+	}{
+		{name: "both nil", a: nil, b: nil, want: true},
+		{name: "nil and empty", a: nil, b: []int{}, want: true},
+		{name: "equal", a: []int{1, 2, 3}, b: []int{1, 2, 3}, want: true},
+		{name: "different length", a: []int{1, 2}, b: []int{1, 2, 3}, want: false},
+		{name: "different values", a: []int{1, 2, 3}, b: []int{1, 4, 3}, want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Comparable(t, "Equal", tc.want, Equal(tc.a, tc.b))
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	type userDTO struct {
+		ID string
+	}
+	equals := func(u user, dto userDTO) bool {
+		return fmt.Sprint(u.id) == dto.ID
+	}
+	users := []user{{1, "a"}, {2, "b"}}
+	assert.Comparable(t, "equal", true, EqualFunc(users, []userDTO{{"1"}, {"2"}}, equals))
+	assert.Comparable(t, "mismatch", false, EqualFunc(users, []userDTO{{"1"}, {"3"}}, equals))
+	assert.Comparable(t, "different length", false, EqualFunc(users, []userDTO{{"1"}}, equals))
+	assert.Comparable(t, "nil and empty", true, EqualFunc([]user(nil), []userDTO{}, equals))
+
+	var calls int
+	EqualFunc([]int{1, 2, 3}, []int{9, 2, 3}, func(a, b int) bool {
+		calls++
+		return a == b
+	})
+	assert.Comparable(t, "short-circuit calls", 1, calls)
+}
+
+func TestCount(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice []string
+		value string
+		want  int
+	}{
+		{name: "nil slice", slice: nil, value: "a", want: 0},
+		{name: "no match", slice: []string{"b", "c"}, value: "a", want: 0},
+		{name: "multiple", slice: []string{"a", "b", "a", "c", "a"}, value: "a", want: 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Comparable(t, "count", tc.want, Count(tc.slice, tc.value))
+		})
+	}
+}
+
+func TestCountFunc(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	assert.Comparable(t, "nil slice", 0, CountFunc([]int(nil), isEven))
+	assert.Comparable(t, "values", 3, CountFunc([]int{1, 2, 3, 4, 5, 6}, isEven))
+}
+
+func TestFilterMap(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6}
+	got := FilterMap(in, func(value int) (string, bool) {
+		return fmt.Sprint(value * 10), value%2 == 0
+	})
+	assertSlice(t, "FilterMap", []string{"20", "40", "60"}, got)
+}
+
+func TestFilterMapNoneKept(t *testing.T) {
+	got := FilterMap([]int{1, 2, 3}, func(value int) (string, bool) {
+		return "", false
+	})
+	if got == nil {
+		t.Error("want non-nil empty slice, got nil")
+	}
+	assert.Comparable(t, "len", 0, len(got))
+}
+
+func TestDistinctCount(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		want      []int
+		wantCount int
+	}{
+		{name: "all unique", slice: []int{1, 2, 3}, want: []int{1, 2, 3}, wantCount: 0},
+		{name: "some duplicates", slice: []int{1, 2, 1, 3, 2}, want: []int{1, 2, 3}, wantCount: 2},
+		{name: "all identical", slice: []int{4, 4, 4, 4}, want: []int{4}, wantCount: 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, count := DistinctCount(tc.slice)
+			assertSlice(t, "DistinctCount", tc.want, got)
+			assert.Comparable(t, "count", tc.wantCount, count)
+		})
+	}
+}
+
+func TestDistinctBy(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+	in := []record{
+		{1, "first"},
+		{2, "second"},
+		{1, "third"},
+		{3, "fourth"},
+		{2, "fifth"},
+	}
+	got := DistinctBy(in, func(value record) int {
+		return value.id
+	})
+	want := []record{
+		{1, "first"},
+		{2, "second"},
+		{3, "fourth"},
+	}
+	assertSlice(t, "DistinctBy", want, got)
+}
+
+func TestFind(t *testing.T) {
+	in := []string{"apple", "banana", "cherry"}
+	testCases := []struct {
+		name      string
+		prefix    byte
+		wantValue string
+		wantIndex int
+		wantFound bool
+	}{
+		{name: "first", prefix: 'a', wantValue: "apple", wantIndex: 0, wantFound: true},
+		{name: "middle", prefix: 'b', wantValue: "banana", wantIndex: 1, wantFound: true},
+		{name: "last", prefix: 'c', wantValue: "cherry", wantIndex: 2, wantFound: true},
+		{name: "none", prefix: 'd', wantValue: "", wantIndex: -1, wantFound: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, index, found := Find(in, func(value string) bool {
+				return value[0] == tc.prefix
+			})
+			assert.Comparable(t, "value", tc.wantValue, value)
+			assert.Comparable(t, "index", tc.wantIndex, index)
+			assert.Comparable(t, "found", tc.wantFound, found)
+		})
+	}
+}
+
+func TestFirstNonEmpty(t *testing.T) {
+	got := FirstNonEmpty(nil, []int{}, []int{1, 2}, []int{3})
+	assertSlice(t, "leading empties", []int{1, 2}, got)
+
+	got = FirstNonEmpty(nil, []int{})
+	if got != nil {
+		t.Errorf("all empty: want nil, got %v", got)
+	}
+}
+
+func TestCoalesceFunc(t *testing.T) {
+	type config struct {
+		Name string
+		Tags []string
+	}
+	isZero := func(c config) bool {
+		return c.Name == "" && len(c.Tags) == 0
+	}
+
+	got, ok := CoalesceFunc([]config{{}, {Tags: []string{}}, {Name: "b"}, {Name: "c"}}, isZero)
+	assert.Comparable(t, "found", true, ok)
+	assert.Comparable(t, "name", "b", got.Name)
+
+	got, ok = CoalesceFunc([]config{{}, {}}, isZero)
+	assert.Comparable(t, "all zero found", false, ok)
+	assert.Comparable(t, "all zero name", "", got.Name)
+
+	_, ok = CoalesceFunc([]config(nil), isZero)
+	assert.Comparable(t, "nil slice found", false, ok)
+}
+
 func TestFold(t *testing.T) {
 	testCases := []struct {
 		name  string
@@ -220,6 +580,95 @@ func TestFold(t *testing.T) {
 	}
 }
 
+func TestReduce(t *testing.T) {
+	errStop := errors.New("stop")
+	testCases := []struct {
+		name    string
+		slice   []string
+		seed    string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "values",
+			slice: []string{"a", "b", "c"},
+			seed:  "",
+			want:  "abc",
+		},
+		{
+			name:  "nil slice",
+			slice: nil,
+			seed:  "seed",
+			want:  "seed",
+		},
+		{
+			name:    "error",
+			slice:   []string{"a", "b", "!", "c"},
+			seed:    "",
+			want:    "ab",
+			wantErr: errStop,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotStr, err := Reduce(tc.slice, tc.seed, func(state string, value string) (string, error) {
+				if value == "!" {
+					return "", errStop
+				}
+				return state + value, nil
+			})
+			if err != tc.wantErr {
+				t.Errorf("want error %v, got %v", tc.wantErr, err)
+			}
+			if gotStr != tc.want {
+				t.Errorf("want %q, got %q", tc.want, gotStr)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	sum := func(state, value int) int { return state + value }
+	assertSlice(t, "running total", []int{11, 13, 16, 20}, Scan([]int{1, 2, 3, 4}, 10, sum))
+
+	max := func(state, value int) int {
+		if value > state {
+			return value
+		}
+		return state
+	}
+	got := Scan([]int{3, 1, 4, 1, 5}, 0, max)
+	assertSlice(t, "cumulative max", []int{3, 3, 4, 4, 5}, got)
+
+	got = Scan([]int{}, 10, sum)
+	if got == nil || len(got) != 0 {
+		t.Errorf("empty slice: want empty non-nil slice, got %#v", got)
+	}
+}
+
+func TestReduceWhile(t *testing.T) {
+	var processed []int
+	sumUntil := func(sum, value int) (int, bool) {
+		processed = append(processed, value)
+		if sum+value > 10 {
+			return sum, false
+		}
+		return sum + value, true
+	}
+
+	got := ReduceWhile([]int{3, 4, 2, 5, 1, 1}, 0, sumUntil)
+	assert.Comparable(t, "stopped sum", 9, got)
+	assertSlice(t, "processed", []int{3, 4, 2, 5}, processed)
+
+	processed = nil
+	got = ReduceWhile([]int{1, 2, 3}, 0, sumUntil)
+	assert.Comparable(t, "full sum", 6, got)
+	assertSlice(t, "processed all", []int{1, 2, 3}, processed)
+
+	got = ReduceWhile([]int(nil), 42, sumUntil)
+	assert.Comparable(t, "nil slice", 42, got)
+}
+
 func TestFoldReverse(t *testing.T) {
 	testCases := []struct {
 		name  string
@@ -290,6 +739,49 @@ func TestConcat(t *testing.T) {
 	}
 }
 
+func TestJoin(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice []string
+		want  []string
+	}{
+		{name: "empty", slice: []string{}, want: []string{}},
+		{name: "single", slice: []string{"a"}, want: []string{"a"}},
+		{name: "two", slice: []string{"a", "b"}, want: []string{"a", ",", "b"}},
+		{name: "many", slice: []string{"a", "b", "c", "d"}, want: []string{"a", ",", "b", ",", "c", ",", "d"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Join(tc.slice, ",")
+			assertSlice(t, "Join", tc.want, got)
+			assert.Comparable(t, "cap", len(tc.want), cap(got))
+		})
+	}
+}
+
+func TestJoinReturnsCopy(t *testing.T) {
+	slice := []int{1}
+	got := Join(slice, 0)
+	got[0] = 2
+	assert.Comparable(t, "original", 1, slice[0])
+}
+
+func TestFlatten(t *testing.T) {
+	got := Flatten([][]int{{1, 2}, nil, {}, {3}, {4, 5}})
+	assertSlice(t, "Flatten", []int{1, 2, 3, 4, 5}, got)
+
+	if got := Flatten([][]int{}); got == nil {
+		t.Error("want non-nil empty slice, got nil")
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	got := FlatMap([]string{"ab", "", "cde"}, func(value string) []byte {
+		return []byte(value)
+	})
+	assertSlice(t, "FlatMap", []byte("abcde"), got)
+}
+
 func TestGroupBy(t *testing.T) {
 	in := []string{
 		"Potatoes",
@@ -319,6 +811,36 @@ func TestGroupBy(t *testing.T) {
 	assertSlice(t, "group[2]", []string{"Toast"}, got[2].Values)
 }
 
+func TestGroupConsecutive(t *testing.T) {
+	in := []string{
+		"Potatoes",
+		"Pizza",
+		"Hamburger",
+		"Pancake",
+		"Toast",
+		"Tacos",
+	}
+	got := GroupConsecutive(in, func(value string) byte {
+		return value[0]
+	})
+	if len(got) != 4 {
+		t.Fatalf("want 4 groups, got %d: %v", len(got), got)
+	}
+	wantKeys := []byte{'P', 'H', 'P', 'T'}
+	wantValues := [][]string{
+		{"Potatoes", "Pizza"},
+		{"Hamburger"},
+		{"Pancake"},
+		{"Toast", "Tacos"},
+	}
+	for i, group := range got {
+		if group.Key != wantKeys[i] {
+			t.Errorf("want group[%d].Key = %q, got %q", i, wantKeys[i], group.Key)
+		}
+		assertSlice(t, fmt.Sprintf("group[%d]", i), wantValues[i], group.Values)
+	}
+}
+
 func TestCountBy(t *testing.T) {
 	in := []string{
 		"Potatoes",
@@ -348,6 +870,89 @@ func TestCountBy(t *testing.T) {
 	assert.Comparable(t, "group[2]", 1, got[2].Count)
 }
 
+func TestFrequencies(t *testing.T) {
+	got := Frequencies([]string{"a", "b", "a", "c", "a", "b"})
+	if len(got) != 3 {
+		t.Fatalf("want len=3, got len=%d: %v", len(got), got)
+	}
+	assert.Comparable(t, "a", 3, got["a"])
+	assert.Comparable(t, "b", 2, got["b"])
+	assert.Comparable(t, "c", 1, got["c"])
+
+	got = Frequencies([]string{"x", "y"})
+	assert.Comparable(t, "unique x", 1, got["x"])
+	assert.Comparable(t, "unique y", 1, got["y"])
+
+	got = Frequencies([]string{})
+	assert.Comparable(t, "empty len", 0, len(got))
+}
+
+func TestAssociate(t *testing.T) {
+	in := []string{"a=1", "b=2", "a=3"}
+	got := Associate(in, func(value string) (string, byte) {
+		return value[:1], value[2]
+	})
+	if len(got) != 2 {
+		t.Fatalf("want len=2, got len=%d: %v", len(got), got)
+	}
+	assert.Comparable(t, "a", '3', got["a"])
+	assert.Comparable(t, "b", '2', got["b"])
+}
+
+func TestAssociateBy(t *testing.T) {
+	in := []string{"apple", "banana", "avocado"}
+	got := AssociateBy(in, func(value string) byte {
+		return value[0]
+	})
+	if len(got) != 2 {
+		t.Fatalf("want len=2, got len=%d: %v", len(got), got)
+	}
+	assert.Comparable(t, "a", "avocado", got['a'])
+	assert.Comparable(t, "b", "banana", got['b'])
+}
+
+func TestAssociateWith(t *testing.T) {
+	in := []string{"a", "bb", "ccc"}
+	got := AssociateWith(in, func(key string) int {
+		return len(key)
+	})
+	if len(got) != 3 {
+		t.Fatalf("want len=3, got len=%d: %v", len(got), got)
+	}
+	assert.Comparable(t, "a", 1, got["a"])
+	assert.Comparable(t, "bb", 2, got["bb"])
+	assert.Comparable(t, "ccc", 3, got["ccc"])
+}
+
+func TestToMap(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+	in := []record{{1, "a"}, {2, "b"}, {1, "c"}}
+	got := ToMap(in, func(r record) int { return r.id }, func(r record) string { return r.name })
+	if len(got) != 2 {
+		t.Fatalf("want len=2, got len=%d: %v", len(got), got)
+	}
+	assert.Comparable(t, "1", "c", got[1])
+	assert.Comparable(t, "2", "b", got[2])
+}
+
+func TestToMapKeyed(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+	in := []record{{1, "a"}, {2, "b"}, {3, "c"}}
+	got := ToMapKeyed(in, func(r record) int { return r.id })
+	if len(got) != 3 {
+		t.Fatalf("want len=3, got len=%d: %v", len(got), got)
+	}
+	for _, r := range in {
+		assert.Comparable(t, fmt.Sprint(r.id), r, got[r.id])
+	}
+}
+
 func TestPairs(t *testing.T) {
 	in := []byte("abcdefg")
 	got := Pairs(in)
@@ -410,6 +1015,40 @@ func TestWindowedFunc(t *testing.T) {
 	}
 }
 
+func TestPairsErr(t *testing.T) {
+	errStop := errors.New("stop")
+	in := []byte("abcdefg")
+	var got []string
+	err := PairsErr(in, func(a, b byte) error {
+		got = append(got, string([]byte{a, b}))
+		if len(got) == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("want error %v, got %v", errStop, err)
+	}
+	assertSlice(t, "visited", []string{"ab", "bc", "cd"}, got)
+}
+
+func TestWindowedErr(t *testing.T) {
+	errStop := errors.New("stop")
+	in := []byte("abcdefg")
+	var got []string
+	err := WindowedErr(in, 3, func(window []byte) error {
+		got = append(got, string(window))
+		if len(got) == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("want error %v, got %v", errStop, err)
+	}
+	assertSlice(t, "visited", []string{"abc", "bcd", "cde"}, got)
+}
+
 func TestChunk(t *testing.T) {
 	in := []byte("abcdefg")
 	got := Chunk(in, 3)
@@ -441,6 +1080,406 @@ func TestChunkFunc(t *testing.T) {
 	}
 }
 
+func TestChunkErr(t *testing.T) {
+	errStop := errors.New("stop")
+	in := []byte("abcdefghij")
+	var got []string
+	err := ChunkErr(in, 2, func(chunk []byte) error {
+		got = append(got, string(chunk))
+		if len(got) == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("want error %v, got %v", errStop, err)
+	}
+	assertSlice(t, "visited", []string{"ab", "cd", "ef"}, got)
+
+	got = nil
+	err = ChunkErr([]byte("abcdefg"), 3, func(chunk []byte) error {
+		got = append(got, string(chunk))
+		return nil
+	})
+	if err != nil {
+		t.Errorf("want nil error, got %v", err)
+	}
+	assertSlice(t, "all chunks", []string{"abc", "def", "g"}, got)
+}
+
+func TestChunkByCount(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice string
+		count int
+		want  []string
+	}{
+		{
+			name:  "even",
+			slice: "abcdef",
+			count: 3,
+			want:  []string{"ab", "cd", "ef"},
+		},
+		{
+			name:  "uneven",
+			slice: "abcdefg",
+			count: 3,
+			want:  []string{"abc", "de", "fg"},
+		},
+		{
+			name:  "count exceeds length",
+			slice: "ab",
+			count: 4,
+			want:  []string{"a", "b"},
+		},
+		{
+			name:  "empty",
+			slice: "",
+			count: 4,
+			want:  nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ChunkByCount([]byte(tc.slice), tc.count)
+			gotStrs := Map(got, func(chunk []byte) string {
+				return string(chunk)
+			})
+			assertSlice(t, "chunks", tc.want, gotStrs)
+		})
+	}
+}
+
+func TestChunkByCountNonPositive(t *testing.T) {
+	for _, count := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("want panic for count %d, got none", count)
+				}
+			}()
+			ChunkByCount([]int{1, 2, 3}, count)
+		}()
+	}
+}
+
+func TestMinMaxFloat(t *testing.T) {
+	nan := math.NaN()
+	testCases := []struct {
+		name    string
+		slice   []float64
+		wantMin float64
+		wantMax float64
+	}{
+		{
+			name:    "NaN at start",
+			slice:   []float64{nan, 1, 2},
+			wantMin: 1,
+			wantMax: 2,
+		},
+		{
+			name:    "NaN in middle",
+			slice:   []float64{1, nan, 2},
+			wantMin: 1,
+			wantMax: 2,
+		},
+		{
+			name:    "NaN at end",
+			slice:   []float64{1, 2, nan},
+			wantMin: 1,
+			wantMax: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Comparable(t, "min", tc.wantMin, MinFloat(tc.slice))
+			assert.Comparable(t, "max", tc.wantMax, MaxFloat(tc.slice))
+		})
+	}
+}
+
+func TestMinMaxFloatAllNaN(t *testing.T) {
+	slice := []float64{math.NaN(), math.NaN()}
+	if got := MinFloat(slice); !math.IsNaN(got) {
+		t.Errorf("min: want NaN, got %v", got)
+	}
+	if got := MaxFloat(slice); !math.IsNaN(got) {
+		t.Errorf("max: want NaN, got %v", got)
+	}
+}
+
+func TestZip(t *testing.T) {
+	testCases := []struct {
+		name  string
+		a     []int
+		b     []string
+		wantA []int
+		wantB []string
+	}{
+		{
+			name:  "equal length",
+			a:     []int{1, 2, 3},
+			b:     []string{"a", "b", "c"},
+			wantA: []int{1, 2, 3},
+			wantB: []string{"a", "b", "c"},
+		},
+		{
+			name:  "a shorter",
+			a:     []int{1, 2},
+			b:     []string{"a", "b", "c"},
+			wantA: []int{1, 2},
+			wantB: []string{"a", "b"},
+		},
+		{
+			name:  "b shorter",
+			a:     []int{1, 2, 3},
+			b:     []string{"a"},
+			wantA: []int{1},
+			wantB: []string{"a"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pairs := Zip(tc.a, tc.b)
+			if len(pairs) != len(tc.wantA) {
+				t.Fatalf("want len=%d, got len=%d", len(tc.wantA), len(pairs))
+			}
+			for i, p := range pairs {
+				assert.Comparable(t, fmt.Sprintf("pairs[%d].A", i), tc.wantA[i], p.A)
+				assert.Comparable(t, fmt.Sprintf("pairs[%d].B", i), tc.wantB[i], p.B)
+			}
+			gotA, gotB := Unzip(pairs)
+			assertSlice(t, "unzipped a", tc.wantA, gotA)
+			assertSlice(t, "unzipped b", tc.wantB, gotB)
+		})
+	}
+}
+
+func TestZipEmpty(t *testing.T) {
+	pairs := Zip([]int{}, []string{"a", "b"})
+	if pairs == nil {
+		t.Error("want non-nil empty slice, got nil")
+	}
+	assert.Comparable(t, "len", 0, len(pairs))
+}
+
+func TestChunkedMapParallel(t *testing.T) {
+	conv := func(chunk []int) []string {
+		result := make([]string, len(chunk))
+		for i, v := range chunk {
+			result[i] = fmt.Sprint(v * 10)
+		}
+		return result
+	}
+	slice := make([]int, 103)
+	for i := range slice {
+		slice[i] = i
+	}
+	var want []string
+	ChunkFunc(slice, 10, func(chunk []int) {
+		want = append(want, conv(chunk)...)
+	})
+
+	for _, workers := range []int{0, 1, 3, 50} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			got := ChunkedMapParallel(slice, 10, workers, conv)
+			assertSlice(t, "ChunkedMapParallel", want, got)
+		})
+	}
+
+	got := ChunkedMapParallel([]int{}, 10, 4, conv)
+	if got == nil || len(got) != 0 {
+		t.Errorf("empty slice: want empty non-nil slice, got %#v", got)
+	}
+}
+
+func TestFirstNLastN(t *testing.T) {
+	testCases := []struct {
+		name      string
+		n         int
+		wantFirst string
+		wantLast  string
+	}{
+		{name: "zero", n: 0, wantFirst: "", wantLast: ""},
+		{name: "negative", n: -1, wantFirst: "", wantLast: ""},
+		{name: "less than len", n: 2, wantFirst: "ab", wantLast: "de"},
+		{name: "len", n: 5, wantFirst: "abcde", wantLast: "abcde"},
+		{name: "more than len", n: 10, wantFirst: "abcde", wantLast: "abcde"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slice := []byte("abcde")
+			assert.Comparable(t, "FirstN", tc.wantFirst, string(FirstN(slice, tc.n)))
+			assert.Comparable(t, "LastN", tc.wantLast, string(LastN(slice, tc.n)))
+		})
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type item struct {
+		name  string
+		price int
+	}
+	items := []item{{"a", 5}, {"b", 2}, {"c", 9}, {"d", 2}, {"e", 9}}
+	price := func(i item) int { return i.price }
+
+	min, ok := MinBy(items, price)
+	assert.Comparable(t, "MinBy ok", true, ok)
+	assert.Comparable(t, "MinBy first of ties", "b", min.name)
+
+	max, ok := MaxBy(items, price)
+	assert.Comparable(t, "MaxBy ok", true, ok)
+	assert.Comparable(t, "MaxBy first of ties", "c", max.name)
+
+	_, ok = MinBy([]item{}, price)
+	assert.Comparable(t, "MinBy empty ok", false, ok)
+	_, ok = MaxBy([]item(nil), price)
+	assert.Comparable(t, "MaxBy nil ok", false, ok)
+}
+
+func TestSumByAverageBy(t *testing.T) {
+	type lineItem struct {
+		name  string
+		price float64
+		count int
+	}
+	items := []lineItem{{"a", 1.5, 2}, {"b", 2.5, 3}, {"c", 5, 2}}
+	price := func(i lineItem) float64 { return i.price }
+	count := func(i lineItem) int { return i.count }
+
+	assert.Comparable(t, "SumBy float", 9.0, SumBy(items, price))
+	assert.Comparable(t, "SumBy int", 7, SumBy(items, count))
+	assert.Comparable(t, "SumBy empty", 0, SumBy([]lineItem{}, count))
+
+	avg, ok := AverageBy(items, price)
+	assert.Comparable(t, "AverageBy float ok", true, ok)
+	assert.Comparable(t, "AverageBy float", 3.0, avg)
+
+	avgInt, ok := AverageBy(items, count)
+	assert.Comparable(t, "AverageBy int ok", true, ok)
+	assert.Comparable(t, "AverageBy int truncated", 2, avgInt)
+
+	avg, ok = AverageBy([]lineItem(nil), price)
+	assert.Comparable(t, "AverageBy empty ok", false, ok)
+	assert.Comparable(t, "AverageBy empty", 0.0, avg)
+}
+
+func TestAverageBySmallNumberType(t *testing.T) {
+	avgU, ok := AverageBy(Repeat[uint8](1, 255), func(v uint8) uint8 { return v })
+	assert.Comparable(t, "uint8 max length ok", true, ok)
+	assert.Comparable(t, "uint8 max length", uint8(1), avgU)
+
+	avgI, ok := AverageBy(Repeat[int8](-1, 127), func(v int8) int8 { return v })
+	assert.Comparable(t, "int8 max length ok", true, ok)
+	assert.Comparable(t, "int8 max length", int8(-1), avgI)
+
+	avgC, ok := AverageBy([]complex64{1 + 2i, 3 + 4i}, func(v complex64) complex64 { return v })
+	assert.Comparable(t, "complex ok", true, ok)
+	assert.Comparable(t, "complex", complex64(2+3i), avgC)
+}
+
+func TestAverageByLengthOverflowPanics(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{name: "uint8", f: func() { AverageBy(make([]uint8, 256), func(v uint8) uint8 { return v }) }},
+		{name: "int8", f: func() { AverageBy(make([]int8, 128), func(v int8) int8 { return v }) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("want panic when length overflows number type, got none")
+				}
+			}()
+			tc.f()
+		})
+	}
+}
+
+func TestMinMaxFunc(t *testing.T) {
+	type item struct {
+		key  int
+		name string
+	}
+	less := func(a, b item) bool {
+		return a.key < b.key
+	}
+	testCases := []struct {
+		name    string
+		slice   []item
+		wantMin item
+		wantMax item
+		wantOK  bool
+	}{
+		{
+			name:    "unsorted",
+			slice:   []item{{5, "a"}, {2, "b"}, {8, "c"}, {1, "d"}, {9, "e"}, {3, "f"}},
+			wantMin: item{1, "d"},
+			wantMax: item{9, "e"},
+			wantOK:  true,
+		},
+		{
+			name:    "ties",
+			slice:   []item{{2, "a"}, {1, "b"}, {1, "c"}, {2, "d"}, {1, "e"}},
+			wantMin: item{1, "b"},
+			wantMax: item{2, "a"},
+			wantOK:  true,
+		},
+		{
+			name:    "ties in pair",
+			slice:   []item{{0, "a"}, {3, "b"}, {3, "c"}},
+			wantMin: item{0, "a"},
+			wantMax: item{3, "b"},
+			wantOK:  true,
+		},
+		{
+			name:    "single",
+			slice:   []item{{4, "a"}},
+			wantMin: item{4, "a"},
+			wantMax: item{4, "a"},
+			wantOK:  true,
+		},
+		{
+			name:   "empty",
+			slice:  nil,
+			wantOK: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			min, max, ok := MinMaxFunc(tc.slice, less)
+			assert.Comparable(t, "min", tc.wantMin, min)
+			assert.Comparable(t, "max", tc.wantMax, max)
+			assert.Comparable(t, "ok", tc.wantOK, ok)
+		})
+	}
+}
+
+func TestPadLeftRight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     string
+		length    int
+		wantLeft  string
+		wantRight string
+	}{
+		{name: "longer", slice: "abcde", length: 3, wantLeft: "abcde", wantRight: "abcde"},
+		{name: "exact", slice: "abc", length: 3, wantLeft: "abc", wantRight: "abc"},
+		{name: "shorter", slice: "ab", length: 5, wantLeft: "___ab", wantRight: "ab___"},
+		{name: "empty", slice: "", length: 2, wantLeft: "__", wantRight: "__"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slice := []byte(tc.slice)
+			assert.Comparable(t, "PadLeft", tc.wantLeft, string(PadLeft(slice, tc.length, '_')))
+			assert.Comparable(t, "PadRight", tc.wantRight, string(PadRight(slice, tc.length, '_')))
+			assert.Comparable(t, "original", tc.slice, string(slice))
+		})
+	}
+}
+
 func assertSlice[T comparable](t *testing.T, name string, want, got []T) {
 	if len(want) != len(got) {
 		t.Errorf("%s: want len=%d, got len=%d", name, len(want), len(got))
@@ -5,6 +5,8 @@
 package slices
 
 import (
+	"container/heap"
+	"fmt"
 	"math/rand"
 	"sort"
 
@@ -76,6 +78,107 @@ func SortStableDescFunc[S ~[]E, E any](slice S, less func(a, b E) bool) {
 	sort.Stable(sort.Reverse(sortLess[E]{slice, less}))
 }
 
+// IsSorted returns true if the slice is sorted in ascending order, using the
+// default less-than operator. Empty and single-element slices are always
+// considered sorted.
+func IsSorted[S ~[]E, E typ.Ordered](slice S) bool {
+	for i := len(slice) - 1; i > 0; i-- {
+		if slice[i] < slice[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSortedFunc returns true if the slice is sorted in ascending order, using
+// the given less function. Empty and single-element slices are always
+// considered sorted.
+func IsSortedFunc[S ~[]E, E any](slice S, less func(a, b E) bool) bool {
+	for i := len(slice) - 1; i > 0; i-- {
+		if less(slice[i], slice[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeSortedMany merges any number of already sorted slices into a new
+// sorted slice, using the default less-than operator. The merge is done
+// using a min-heap over the first remaining value of each input slice, giving
+// a time complexity of O(n log k), where n is the total number of values and
+// k is the number of input slices.
+//
+// Equal values are kept in the order of the input slices they came from.
+func MergeSortedMany[S ~[]E, E typ.Ordered](inputs ...S) S {
+	return MergeSortedManyFunc(typ.Less[E], inputs...)
+}
+
+// MergeSortedManyFunc merges any number of already sorted slices into a new
+// sorted slice, using the given less function. See MergeSortedMany for more
+// details.
+func MergeSortedManyFunc[S ~[]E, E any](less func(a, b E) bool, inputs ...S) S {
+	var total int
+	h := mergeHeap[E]{less: less}
+	for i, in := range inputs {
+		total += len(in)
+		if len(in) > 0 {
+			h.heads = append(h.heads, mergeHead[E]{slice: in, input: i})
+		}
+	}
+	heap.Init(&h)
+	result := make(S, 0, total)
+	for len(h.heads) > 0 {
+		head := &h.heads[0]
+		result = append(result, head.slice[0])
+		head.slice = head.slice[1:]
+		if len(head.slice) == 0 {
+			heap.Pop(&h)
+		} else {
+			heap.Fix(&h, 0)
+		}
+	}
+	return result
+}
+
+type mergeHead[T any] struct {
+	slice []T
+	input int
+}
+
+type mergeHeap[T any] struct {
+	heads []mergeHead[T]
+	less  func(a, b T) bool
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.heads)
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.heads[i], h.heads[j] = h.heads[j], h.heads[i]
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.heads[i], h.heads[j]
+	if h.less(a.slice[0], b.slice[0]) {
+		return true
+	}
+	if h.less(b.slice[0], a.slice[0]) {
+		return false
+	}
+	return a.input < b.input
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.heads = append(h.heads, x.(mergeHead[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+	return last
+}
+
 // Reverse will reverse all elements inside a slice, in place.
 func Reverse[S ~[]E, E any](slice S) {
 	for i, j := 0, len(slice)-1; i < len(slice)/2; i, j = i+1, j-1 {
@@ -83,6 +186,38 @@ func Reverse[S ~[]E, E any](slice S) {
 	}
 }
 
+// ReverseRange will reverse the elements inside a slice in the range
+// [start, end), in place. Will panic if the range is outside the bounds of
+// the slice.
+func ReverseRange[S ~[]E, E any](slice S, start, end int) {
+	if start < 0 || end > len(slice) || start > end {
+		panic(fmt.Sprintf("slices.ReverseRange: range [%d:%d] out of bounds with length %d", start, end, len(slice)))
+	}
+	Reverse(slice[start:end])
+}
+
+// Rotate will rotate all elements inside a slice to the left by n positions,
+// in place. A negative n rotates to the right instead. If n is larger than the
+// length of the slice, then it is reduced modulo the length of the slice.
+//
+// This uses the three-reversal algorithm, giving O(n) time complexity without
+// allocating any extra memory.
+func Rotate[S ~[]E, E any](slice S, n int) {
+	if len(slice) < 2 {
+		return
+	}
+	n %= len(slice)
+	if n < 0 {
+		n += len(slice)
+	}
+	if n == 0 {
+		return
+	}
+	Reverse(slice[:n])
+	Reverse(slice[n:])
+	Reverse(slice)
+}
+
 // Shuffle will randomize the order of all elements inside a slice. It uses the
 // rand package for random number generation, so you are expected to have called
 // rand.Seed beforehand.
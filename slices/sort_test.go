@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package slices
+
+import "testing"
+
+func TestReverseRange(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice string
+		start int
+		end   int
+		want  string
+	}{
+		{
+			name:  "interior",
+			slice: "abcdef",
+			start: 1,
+			end:   4,
+			want:  "adcbef",
+		},
+		{
+			name:  "whole",
+			slice: "abcdef",
+			start: 0,
+			end:   6,
+			want:  "fedcba",
+		},
+		{
+			name:  "empty range",
+			slice: "abcdef",
+			start: 3,
+			end:   3,
+			want:  "abcdef",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slice := []byte(tc.slice)
+			ReverseRange(slice, tc.start, tc.end)
+			gotStr := string(slice)
+			if gotStr != tc.want {
+				t.Errorf("want %q, got %q", tc.want, gotStr)
+			}
+		})
+	}
+}
+
+func TestReverseRangeMatchesReverse(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5}
+	b := []int{1, 2, 3, 4, 5}
+	ReverseRange(a, 0, len(a))
+	Reverse(b)
+	assertSlice(t, "ReverseRange", b, a)
+}
+
+func TestIsSorted(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice []int
+		want  bool
+	}{
+		{name: "empty", slice: nil, want: true},
+		{name: "single", slice: []int{1}, want: true},
+		{name: "sorted", slice: []int{1, 2, 3, 4}, want: true},
+		{name: "duplicates", slice: []int{1, 2, 2, 3}, want: true},
+		{name: "reverse sorted", slice: []int{4, 3, 2, 1}, want: false},
+		{name: "one out of place", slice: []int{1, 3, 2, 4}, want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsSorted(tc.slice); got != tc.want {
+				t.Errorf("IsSorted: want %t, got %t", tc.want, got)
+			}
+			got := IsSortedFunc(tc.slice, func(a, b int) bool {
+				return a < b
+			})
+			if got != tc.want {
+				t.Errorf("IsSortedFunc: want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMergeSortedMany(t *testing.T) {
+	testCases := []struct {
+		name   string
+		inputs [][]int
+		want   []int
+	}{
+		{name: "no inputs", inputs: nil, want: []int{}},
+		{name: "only empty inputs", inputs: [][]int{nil, {}}, want: []int{}},
+		{name: "single input", inputs: [][]int{{1, 2, 3}}, want: []int{1, 2, 3}},
+		{
+			name:   "overlapping ranges",
+			inputs: [][]int{{1, 4, 7, 10}, {2, 3, 8}, {}, {0, 5, 6, 9, 11}, {4, 4}},
+			want:   []int{0, 1, 2, 3, 4, 4, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MergeSortedMany(tc.inputs...)
+			assertSlice(t, "MergeSortedMany", tc.want, got)
+			if !IsSorted(got) {
+				t.Errorf("want sorted result, got %v", got)
+			}
+		})
+	}
+}
+
+func TestMergeSortedManyFunc(t *testing.T) {
+	type item struct {
+		key   int
+		input string
+	}
+	got := MergeSortedManyFunc(func(a, b item) bool { return a.key > b.key },
+		[]item{{9, "a"}, {5, "a"}, {1, "a"}},
+		[]item{{8, "b"}, {5, "b"}, {2, "b"}},
+	)
+	want := []item{{9, "a"}, {8, "b"}, {5, "a"}, {5, "b"}, {2, "b"}, {1, "a"}}
+	assertSlice(t, "MergeSortedManyFunc", want, got)
+}
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice []int
+		n     int
+		want  []int
+	}{
+		{name: "empty", slice: []int{}, n: 3, want: []int{}},
+		{name: "single", slice: []int{1}, n: 5, want: []int{1}},
+		{name: "zero", slice: []int{1, 2, 3, 4, 5}, n: 0, want: []int{1, 2, 3, 4, 5}},
+		{name: "left", slice: []int{1, 2, 3, 4, 5}, n: 2, want: []int{3, 4, 5, 1, 2}},
+		{name: "right", slice: []int{1, 2, 3, 4, 5}, n: -2, want: []int{4, 5, 1, 2, 3}},
+		{name: "full length", slice: []int{1, 2, 3, 4, 5}, n: 5, want: []int{1, 2, 3, 4, 5}},
+		{name: "larger than length", slice: []int{1, 2, 3, 4, 5}, n: 12, want: []int{3, 4, 5, 1, 2}},
+		{name: "negative larger than length", slice: []int{1, 2, 3, 4, 5}, n: -7, want: []int{4, 5, 1, 2, 3}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			Rotate(tc.slice, tc.n)
+			assertSlice(t, "Rotate", tc.want, tc.slice)
+		})
+	}
+}
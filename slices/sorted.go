@@ -85,6 +85,15 @@ func (s *Sorted[T]) Len() int {
 	return len(s.slice)
 }
 
+// Slice returns a copy of all values in sorted order. Modifying the returned
+// slice does not affect the sorted slice.
+func (s *Sorted[T]) Slice() []T {
+	if s == nil {
+		return nil
+	}
+	return Clone(s.slice)
+}
+
 func (s *Sorted[T]) Add(value T) int {
 	if s == nil {
 		panic("sortedslice: tried to add to nil sortedslice")
@@ -94,6 +103,21 @@ func (s *Sorted[T]) Add(value T) int {
 	return index
 }
 
+// AddIfAbsent adds the value only if no equal value, according to the compare
+// function, already exists in the sorted slice. Returns the index of the added
+// or already existing value, and true if the value was added.
+func (s *Sorted[T]) AddIfAbsent(value T) (int, bool) {
+	if s == nil {
+		panic("sortedslice: tried to add to nil sortedslice")
+	}
+	index := s.search(value)
+	if index < len(s.slice) && s.compare(s.slice[index], value) == 0 {
+		return index, false
+	}
+	Insert(&s.slice, index, value)
+	return index, true
+}
+
 func (s *Sorted[T]) RemoveAt(index int) {
 	if index < 0 || index >= s.Len() {
 		panic(fmt.Sprintf("sortedslice: index out of range [%d] with length %d", index, s.Len()))
@@ -48,3 +48,32 @@ func testSortedStrings(t *testing.T, slice Sorted[string]) {
 
 	assert.Comparable(t, "contains e", false, slice.Contains("e"))
 }
+
+func TestSortedAddIfAbsent(t *testing.T) {
+	slice := NewSortedOrdered([]int{1, 3, 5})
+
+	index, added := slice.AddIfAbsent(4)
+	assert.Comparable(t, "new index", 2, index)
+	assert.Comparable(t, "new added", true, added)
+
+	index, added = slice.AddIfAbsent(3)
+	assert.Comparable(t, "duplicate index", 1, index)
+	assert.Comparable(t, "duplicate added", false, added)
+
+	assert.Comparable(t, "len", 4, slice.Len())
+	assert.Comparable(t, "string", "[1 3 4 5]", slice.String())
+}
+
+func TestSortedSliceIsCopy(t *testing.T) {
+	slice := NewSortedOrdered([]int{5, 1, 3})
+
+	got := slice.Slice()
+	assertSlice(t, "values", []int{1, 3, 5}, got)
+
+	got[0] = 100
+	got[2] = -100
+	assertSlice(t, "values after mutation", []int{1, 3, 5}, slice.Slice())
+	assert.Comparable(t, "contains 1", true, slice.Contains(1))
+	assert.Comparable(t, "contains 100", false, slice.Contains(100))
+	assert.Comparable(t, "index 5", 2, slice.Index(5))
+}
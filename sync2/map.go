@@ -7,6 +7,7 @@
 package sync2
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 	"unsafe"
@@ -297,6 +298,37 @@ func (m *Map[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
 	return typ.Zero[V](), false
 }
 
+// loadAndDeleteIf deletes the value for a key if f returns true for the
+// value currently stored on that key, returning the deleted value if any.
+// The loaded result reports whether the value was deleted.
+func (m *Map[K, V]) loadAndDeleteIf(key K, f func(value V) bool) (value V, loaded bool) {
+	read, _ := m.read.Load().(readOnly[K, V])
+	e, ok := read.m[key]
+	if !ok && read.amended {
+		m.mu.Lock()
+		read, _ = m.read.Load().(readOnly[K, V])
+		e, ok = read.m[key]
+		if !ok && read.amended {
+			// Entries only found in the dirty map can only be stored to with
+			// m.mu held, so the value cannot change before deleting it.
+			e, ok = m.dirty[key]
+			if ok {
+				if v, exists := e.load(); !exists || !f(v) {
+					ok = false
+				} else {
+					delete(m.dirty, key)
+				}
+			}
+			m.missLocked()
+		}
+		m.mu.Unlock()
+	}
+	if ok {
+		return e.deleteIf(f)
+	}
+	return typ.Zero[V](), false
+}
+
 // Delete deletes the value for a key.
 func (m *Map[K, V]) Delete(key K) {
 	m.LoadAndDelete(key)
@@ -314,6 +346,18 @@ func (m *entry[T]) delete() (value T, ok bool) {
 	}
 }
 
+func (m *entry[T]) deleteIf(f func(value T) bool) (value T, ok bool) {
+	for {
+		p := atomic.LoadPointer(&m.p)
+		if p == nil || p == expunged || !f(*(*T)(p)) {
+			return typ.Zero[T](), false
+		}
+		if atomic.CompareAndSwapPointer(&m.p, p, nil) {
+			return *(*T)(p), true
+		}
+	}
+}
+
 // Range calls f sequentially for each key and value present in the map.
 // If f returns false, range stops the iteration.
 //
@@ -405,3 +449,52 @@ func (m *Map[K, V]) Len() int {
 	})
 	return length
 }
+
+// DeleteFunc deletes all key-value pairs where f returns true, and returns
+// the number of deleted pairs.
+//
+// DeleteFunc is not atomic across the whole map, as it uses [Map.Range] to
+// visit the keys. Any keys that are stored or deleted concurrently may or may
+// not be visited. Each pair is only deleted if its value is still the one
+// that f was called with.
+func (m *Map[K, V]) DeleteFunc(f func(key K, value V) bool) int {
+	var deleted int
+	m.Range(func(key K, _ V) bool {
+		if _, ok := m.loadAndDeleteIf(key, func(cur V) bool { return f(key, cur) }); ok {
+			deleted++
+		}
+		return true
+	})
+	return deleted
+}
+
+// mapPair is a snapshot of a single key-value pair, used by RangeSorted.
+// Declared at package level as Go 1.18 does not support type declarations
+// inside generic functions.
+type mapPair[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// RangeSorted calls f sequentially for each key and value present in the map,
+// in the order given by the less function applied on the keys.
+// If f returns false, range stops the iteration.
+//
+// RangeSorted takes a snapshot of all key-value pairs via [Map.Range] before
+// sorting and iterating them, so any keys or values stored or deleted
+// concurrently after the snapshot is taken will not be reflected.
+func (m *Map[K, V]) RangeSorted(less func(a, b K) bool, f func(key K, value V) bool) {
+	var pairs []mapPair[K, V]
+	m.Range(func(key K, value V) bool {
+		pairs = append(pairs, mapPair[K, V]{key, value})
+		return true
+	})
+	sort.Slice(pairs, func(i, j int) bool {
+		return less(pairs[i].key, pairs[j].key)
+	})
+	for _, p := range pairs {
+		if !f(p.key, p.value) {
+			break
+		}
+	}
+}
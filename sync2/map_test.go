@@ -262,3 +262,60 @@ func TestNestedLen(t *testing.T) {
 		return true
 	})
 }
+
+func TestRangeSorted(t *testing.T) {
+	var m sync2.Map[int, string]
+	for _, k := range []int{5, 3, 8, 1, 9, 2} {
+		m.Store(k, "v")
+	}
+
+	var keys []int
+	m.RangeSorted(func(a, b int) bool { return a < b }, func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	want := []int{1, 2, 3, 5, 8, 9}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("RangeSorted visited %v, want %v", keys, want)
+	}
+}
+
+func TestRangeSortedStop(t *testing.T) {
+	var m sync2.Map[int, string]
+	for _, k := range []int{5, 3, 8, 1, 9, 2} {
+		m.Store(k, "v")
+	}
+
+	var keys []int
+	m.RangeSorted(func(a, b int) bool { return a < b }, func(key int, _ string) bool {
+		keys = append(keys, key)
+		return len(keys) < 3
+	})
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("RangeSorted visited %v, want %v", keys, want)
+	}
+}
+
+func TestDeleteFunc(t *testing.T) {
+	var m sync2.Map[int, int]
+	for i := 1; i <= 10; i++ {
+		m.Store(i, i*10)
+	}
+
+	deleted := m.DeleteFunc(func(_ int, value int) bool {
+		return value > 50
+	})
+	if deleted != 5 {
+		t.Errorf("DeleteFunc deleted %d entries, want 5", deleted)
+	}
+	if length := m.Len(); length != 5 {
+		t.Errorf("Len returned %d after DeleteFunc, want 5", length)
+	}
+	for i := 1; i <= 10; i++ {
+		_, ok := m.Load(i)
+		if want := i <= 5; ok != want {
+			t.Errorf("Load(%d) ok=%t, want %t", i, ok, want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package sync2
+
+import (
+	"sync"
+	"time"
+
+	"gopkg.in/typ.v4"
+)
+
+// TTLMap is a Map where each entry expires after a duration given when the
+// entry is stored. It is safe for concurrent use by multiple goroutines.
+//
+// Expired entries are evicted lazily, meaning they are only deleted when
+// trying to load them, or when calling EvictExpired. Expired entries that are
+// never accessed again will therefore stay in memory. Use StartJanitor to
+// actively evict expired entries periodically in a background goroutine, at
+// the cost of iterating the whole map on each interval.
+//
+// The zero TTLMap is empty and ready for use. A TTLMap must not be copied
+// after first use.
+type TTLMap[K comparable, V any] struct {
+	m Map[K, *ttlEntry[V]]
+}
+
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func (e *ttlEntry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// Load returns the value stored in the map for a key, or the zero value if no
+// value is present or if it has expired. Expired entries are deleted.
+// The ok result indicates whether value was found in the map.
+func (m *TTLMap[K, V]) Load(key K) (value V, ok bool) {
+	e, ok := m.m.Load(key)
+	if !ok {
+		return typ.Zero[V](), false
+	}
+	if e.expired(time.Now()) {
+		m.m.loadAndDeleteIf(key, func(current *ttlEntry[V]) bool {
+			return current == e
+		})
+		return typ.Zero[V](), false
+	}
+	return e.value, true
+}
+
+// Store sets the value for a key, which expires after the given duration.
+// If the duration is zero or negative, then the entry never expires.
+func (m *TTLMap[K, V]) Store(key K, value V, ttl time.Duration) {
+	e := &ttlEntry[V]{value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	m.m.Store(key, e)
+}
+
+// Delete deletes the value for a key.
+func (m *TTLMap[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// Range calls f sequentially for each key and value present in the map that
+// has not expired. If f returns false, range stops the iteration.
+//
+// See Map.Range for details on concurrent use.
+func (m *TTLMap[K, V]) Range(f func(key K, value V) bool) {
+	now := time.Now()
+	m.m.Range(func(key K, e *ttlEntry[V]) bool {
+		if e.expired(now) {
+			return true
+		}
+		return f(key, e.value)
+	})
+}
+
+// EvictExpired deletes all expired entries, and returns the number of
+// deleted entries.
+func (m *TTLMap[K, V]) EvictExpired() int {
+	var evicted int
+	now := time.Now()
+	m.m.Range(func(key K, _ *ttlEntry[V]) bool {
+		if _, ok := m.m.loadAndDeleteIf(key, func(e *ttlEntry[V]) bool {
+			return e.expired(now)
+		}); ok {
+			evicted++
+		}
+		return true
+	})
+	return evicted
+}
+
+// StartJanitor starts a background goroutine that calls EvictExpired on every
+// interval, until the returned stop function is called. The stop function is
+// safe to call multiple times. Will panic if the interval is zero or negative.
+func (m *TTLMap[K, V]) StartJanitor(interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		panic("sync2.TTLMap.StartJanitor: interval must be positive")
+	}
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.EvictExpired()
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
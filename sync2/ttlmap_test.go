@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package sync2_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/typ.v4/sync2"
+)
+
+func TestTTLMapExpiry(t *testing.T) {
+	var m sync2.TTLMap[string, int]
+	m.Store("short", 1, 20*time.Millisecond)
+	m.Store("forever", 2, 0)
+
+	if v, ok := m.Load("short"); !ok || v != 1 {
+		t.Errorf("Load(short) before expiry = %d, %t, want 1, true", v, ok)
+	}
+
+	time.Sleep(40 * time.Millisecond)
+
+	if v, ok := m.Load("short"); ok {
+		t.Errorf("Load(short) after expiry = %d, %t, want 0, false", v, ok)
+	}
+	if v, ok := m.Load("forever"); !ok || v != 2 {
+		t.Errorf("Load(forever) = %d, %t, want 2, true", v, ok)
+	}
+}
+
+func TestTTLMapEvictExpired(t *testing.T) {
+	var m sync2.TTLMap[int, int]
+	for i := 0; i < 10; i++ {
+		ttl := time.Hour
+		if i%2 == 0 {
+			ttl = time.Nanosecond
+		}
+		m.Store(i, i, ttl)
+	}
+	time.Sleep(time.Millisecond)
+
+	if evicted := m.EvictExpired(); evicted != 5 {
+		t.Errorf("EvictExpired evicted %d entries, want 5", evicted)
+	}
+	var count int
+	m.Range(func(key, _ int) bool {
+		if key%2 == 0 {
+			t.Errorf("Range visited expired key %d", key)
+		}
+		count++
+		return true
+	})
+	if count != 5 {
+		t.Errorf("Range visited %d entries, want 5", count)
+	}
+}
+
+func TestTTLMapJanitor(t *testing.T) {
+	var m sync2.TTLMap[string, int]
+	stop := m.StartJanitor(5 * time.Millisecond)
+	defer stop()
+
+	m.Store("a", 1, time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+
+	if evicted := m.EvictExpired(); evicted != 0 {
+		t.Errorf("EvictExpired evicted %d entries, want janitor to already have evicted them", evicted)
+	}
+	stop()
+}
+
+func TestTTLMapJanitorNonPositiveInterval(t *testing.T) {
+	var m sync2.TTLMap[string, int]
+	for _, interval := range []time.Duration{0, -time.Second} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("want panic for interval %v, got none", interval)
+				}
+			}()
+			m.StartJanitor(interval)
+		}()
+	}
+}
+
+func TestTTLMapConcurrent(t *testing.T) {
+	var m sync2.TTLMap[int, int]
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := i % 16
+				m.Store(key, g, time.Duration(i%3)*time.Microsecond)
+				if v, ok := m.Load(key); ok && (v < 0 || v >= 8) {
+					t.Errorf("Load(%d) returned unexpected value %d", key, v)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
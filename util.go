@@ -4,6 +4,8 @@
 
 package typ
 
+import "reflect"
+
 // Compare checks if either value is greater or equal to the other.
 // The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
 func Compare[T Ordered](a, b T) int {
@@ -62,6 +64,16 @@ func Equal[T comparable](a, b T) bool {
 	return a == b
 }
 
+// DeepEqual returns true if the first argument is deeply equal to the second.
+// This is useful for types that cannot be compared using the == operator,
+// such as slices, maps, and structs containing such types.
+//
+// This relies on reflection via reflect.DeepEqual, making it considerably
+// slower than Equal. Prefer Equal for comparable types.
+func DeepEqual[T any](a, b T) bool {
+	return reflect.DeepEqual(a, b)
+}
+
 // Zero returns the zero value for a given type.
 func Zero[T any]() T {
 	var zero T
@@ -105,6 +117,12 @@ func Coal[T comparable](values ...T) T {
 	return zero
 }
 
+// FirstNonEmptyString returns the first string that is not empty, or an empty
+// string if all strings are empty. This is the same as using Coal on strings.
+func FirstNonEmptyString(values ...string) string {
+	return Coal(values...)
+}
+
 // Tern returns different values depending on the given conditional boolean.
 // Equivalent to the "ternary" operator from other languages.
 // 	var result = 1 > 2 ? "yes" : "no";        // C#, JavaScript, PHP, etc
@@ -125,6 +143,55 @@ func TernCast[T any](cond bool, value any, ifFalse T) T {
 	return ifFalse
 }
 
+// SwitchCase is a conditional value used in Switch. If Func is set, then it
+// is used to lazily produce the value instead of using the Value field.
+type SwitchCase[T any] struct {
+	Cond  bool
+	Value T
+	Func  func() T
+}
+
+func (c SwitchCase[T]) value() T {
+	if c.Func != nil {
+		return c.Func()
+	}
+	return c.Value
+}
+
+// Case returns a new switch case to be used in Switch.
+func Case[T any](cond bool, value T) SwitchCase[T] {
+	return SwitchCase[T]{Cond: cond, Value: value}
+}
+
+// CaseFunc returns a new switch case to be used in Switch, where the value is
+// only evaluated if this case is the one being selected.
+func CaseFunc[T any](cond bool, value func() T) SwitchCase[T] {
+	return SwitchCase[T]{Cond: cond, Func: value}
+}
+
+// Default returns a new switch case that always matches. Meant to be used as
+// the last case in Switch.
+func Default[T any](value T) SwitchCase[T] {
+	return SwitchCase[T]{Cond: true, Value: value}
+}
+
+// Switch returns the value of the first case whose condition is true, or the
+// zero value if no case matched. Works like a chained variant of Tern.
+// 	var result = a > b ? "gt" : a < b ? "lt" : "eq"; // C#, JavaScript, PHP, etc
+// 	var result = typ.Switch(                        // Go
+// 		typ.Case(a > b, "gt"),
+// 		typ.Case(a < b, "lt"),
+// 		typ.Default("eq"))
+func Switch[T any](cases ...SwitchCase[T]) T {
+	for _, c := range cases {
+		if c.Cond {
+			return c.value()
+		}
+	}
+	var zero T
+	return zero
+}
+
 // IsNil checks if the generic value is nil.
 func IsNil[T any](value T) bool {
 	var asAny any = value
@@ -31,3 +31,14 @@ func ExampleTern() {
 	// Output:
 	// To drink I want a glass of milk
 }
+
+func ExampleSwitch() {
+	temperature := 15
+	fmt.Println("It's", typ.Switch(
+		typ.Case(temperature < 0, "freezing"),
+		typ.Case(temperature < 20, "chilly"),
+		typ.Default("warm")))
+
+	// Output:
+	// It's chilly
+}
@@ -21,6 +21,83 @@ func TestIsNil(t *testing.T) {
 	assertIsTrue(t, "any(error(nil))", IsNil(xAsAny))
 }
 
+func TestDeepEqual(t *testing.T) {
+	assertIsTrue(t, "equal slices", DeepEqual([]int{1, 2, 3}, []int{1, 2, 3}))
+	assertIsFalse(t, "unequal slices", DeepEqual([]int{1, 2, 3}, []int{1, 2, 4}))
+	assertIsTrue(t, "equal maps", DeepEqual(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}))
+	assertIsFalse(t, "unequal maps", DeepEqual(map[string]int{"a": 1}, map[string]int{"a": 2}))
+
+	type nested struct {
+		Name   string
+		Values []int
+		Tags   map[string]string
+	}
+	a := nested{"a", []int{1, 2}, map[string]string{"k": "v"}}
+	b := nested{"a", []int{1, 2}, map[string]string{"k": "v"}}
+	c := nested{"a", []int{1, 2}, map[string]string{"k": "other"}}
+	assertIsTrue(t, "equal nested structs", DeepEqual(a, b))
+	assertIsFalse(t, "unequal nested structs", DeepEqual(a, c))
+}
+
+func TestSwitch(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value int
+		want  string
+	}{
+		{name: "first", value: 1, want: "one"},
+		{name: "middle", value: 2, want: "two"},
+		{name: "default", value: 3, want: "many"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Switch(
+				Case(tc.value == 1, "one"),
+				Case(tc.value == 2, "two"),
+				Default("many"))
+			if got != tc.want {
+				t.Errorf("want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSwitchNoMatch(t *testing.T) {
+	got := Switch(Case(false, "a"), Case(false, "b"))
+	if got != "" {
+		t.Errorf("want zero value, got %q", got)
+	}
+}
+
+func TestSwitchLazy(t *testing.T) {
+	var evaluated []string
+	lazy := func(name string) func() string {
+		return func() string {
+			evaluated = append(evaluated, name)
+			return name
+		}
+	}
+	got := Switch(
+		CaseFunc(false, lazy("a")),
+		CaseFunc(true, lazy("b")),
+		CaseFunc(true, lazy("c")))
+	if got != "b" {
+		t.Errorf("want %q, got %q", "b", got)
+	}
+	if len(evaluated) != 1 || evaluated[0] != "b" {
+		t.Errorf("want only [b] evaluated, got %v", evaluated)
+	}
+}
+
+func TestFirstNonEmptyString(t *testing.T) {
+	if got := FirstNonEmptyString("", "", "a", "b"); got != "a" {
+		t.Errorf("leading empties: want %q, got %q", "a", got)
+	}
+	if got := FirstNonEmptyString("", ""); got != "" {
+		t.Errorf("all empty: want %q, got %q", "", got)
+	}
+}
+
 func assertIsTrue(t *testing.T, name string, b bool) {
 	if !b {
 		t.Errorf("%s: want true, got false", name)